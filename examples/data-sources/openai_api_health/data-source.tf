@@ -0,0 +1,9 @@
+data "openai_api_health" "check" {}
+
+output "openai_organization" {
+  value = data.openai_api_health.check.organization
+}
+
+output "openai_latency_ms" {
+  value = data.openai_api_health.check.latency_ms
+}
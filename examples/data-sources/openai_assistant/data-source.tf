@@ -5,3 +5,13 @@ data "openai_assistant" "example" {
 output "assistant_name" {
   value = data.openai_assistant.example.name
 }
+
+# The assistant can also be looked up by name, the name must match exactly one
+# assistant.
+data "openai_assistant" "support" {
+  name = "Support"
+}
+
+output "support_assistant_id" {
+  value = data.openai_assistant.support.id
+}
@@ -0,0 +1,11 @@
+data "openai_audio_transcription" "intro" {
+  file_path       = "${path.module}/media/intro.mp3"
+  model           = "whisper-1"
+  language        = "en"
+  response_format = "vtt"
+}
+
+resource "local_file" "intro_captions" {
+  filename = "${path.module}/media/intro.vtt"
+  content  = data.openai_audio_transcription.intro.text
+}
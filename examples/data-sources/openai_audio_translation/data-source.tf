@@ -0,0 +1,10 @@
+data "openai_audio_translation" "interview" {
+  file_path       = "${path.module}/media/interview-fr.mp3"
+  model           = "whisper-1"
+  response_format = "srt"
+}
+
+resource "local_file" "interview_subtitles" {
+  filename = "${path.module}/media/interview-en.srt"
+  content  = data.openai_audio_translation.interview.text
+}
@@ -0,0 +1,14 @@
+data "openai_batch_output" "summaries" {
+  file_id = var.batch_output_file_id
+
+  lifecycle {
+    postcondition {
+      condition     = self.failed_count == 0
+      error_message = "${self.failed_count} requests of the batch failed."
+    }
+  }
+}
+
+output "summaries" {
+  value = { for custom_id, result in data.openai_batch_output.summaries.results : custom_id => result.content }
+}
@@ -0,0 +1,12 @@
+data "openai_embedding" "example" {
+  model = "text-embedding-3-small"
+  input = [
+    "How do I reset my password?",
+    "Where can I download my invoices?",
+  ]
+  dimensions = 512
+}
+
+output "password_reset_embedding" {
+  value = data.openai_embedding.example.embeddings[0]
+}
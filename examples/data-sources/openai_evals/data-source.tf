@@ -0,0 +1,8 @@
+data "openai_evals" "all" {}
+
+output "failing_evals" {
+  value = [
+    for eval in data.openai_evals.all.evals : eval.name
+    if eval.latest_run != null && eval.latest_run.pass_rate < 0.9
+  ]
+}
@@ -0,0 +1,10 @@
+# A file uploaded outside of Terraform, such as from the OpenAI dashboard.
+data "openai_file" "handbook" {
+  filename = "handbook.pdf"
+  purpose  = "assistants"
+}
+
+resource "openai_vector_store" "handbook" {
+  name     = "Employee handbook"
+  file_ids = [data.openai_file.handbook.id]
+}
@@ -0,0 +1,15 @@
+# Files uploaded for the Batch API during the last 30 days.
+data "openai_files" "batch" {
+  purpose       = "batch"
+  created_after = time_static.audit.unix - 30 * 24 * 60 * 60
+}
+
+resource "time_static" "audit" {}
+
+output "batch_files_bytes" {
+  value = sum(concat([0], [for file in data.openai_files.batch.files : file.bytes]))
+}
+
+output "failed_batch_files" {
+  value = [for file in data.openai_files.batch.files : file.filename if file.status == "error"]
+}
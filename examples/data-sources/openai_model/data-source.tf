@@ -0,0 +1,15 @@
+data "openai_model" "support" {
+  id = "ft:gpt-4o-mini-2024-07-18:my-org::abc123"
+}
+
+resource "openai_assistant" "support" {
+  name  = "Support"
+  model = data.openai_model.support.id
+
+  lifecycle {
+    precondition {
+      condition     = data.openai_model.support.base_model == "gpt-4o-mini-2024-07-18"
+      error_message = "The support model must be fine-tuned from gpt-4o-mini."
+    }
+  }
+}
@@ -0,0 +1,17 @@
+data "openai_moderation" "instructions" {
+  model = "omni-moderation-latest"
+  input = [
+    openai_assistant.example.instructions,
+  ]
+
+  lifecycle {
+    postcondition {
+      condition     = !self.flagged
+      error_message = "The assistant instructions were flagged by the moderation endpoint."
+    }
+  }
+}
+
+output "instructions_scores" {
+  value = data.openai_moderation.instructions.results[0].category_scores
+}
@@ -0,0 +1,10 @@
+data "openai_rate_limits" "gpt" {
+  model = "gpt-4o-mini"
+
+  lifecycle {
+    postcondition {
+      condition     = !self.rate_limited && self.remaining_tokens > 100000
+      error_message = "Not enough tokens left for the batch of completions, retry in ${coalesce(self.reset_tokens, "a few minutes")}."
+    }
+  }
+}
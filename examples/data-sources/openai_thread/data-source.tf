@@ -0,0 +1,10 @@
+data "openai_thread" "support" {
+  id = "thread_abc123"
+}
+
+check "support_thread_wiring" {
+  assert {
+    condition     = contains(data.openai_thread.support.tool_resources.file_search.vector_store_ids, "vs_abc123")
+    error_message = "The support thread does not search the product documentation."
+  }
+}
@@ -0,0 +1,14 @@
+data "openai_users" "ml_team" {
+  emails = ["ada@example.com", "grace@example.com"]
+}
+
+output "ml_team_user_ids" {
+  value = { for user in data.openai_users.ml_team.users : user.email => user.id }
+}
+
+check "ml_team_members" {
+  assert {
+    condition     = length(data.openai_users.ml_team.users) == 2
+    error_message = "Some members of the ML team are not users of the organization."
+  }
+}
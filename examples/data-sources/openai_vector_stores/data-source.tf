@@ -0,0 +1,13 @@
+data "openai_vector_stores" "all" {}
+
+locals {
+  # The vector stores of the search team, by name.
+  search_vector_stores = {
+    for store in data.openai_vector_stores.all.vector_stores : store.name => store.id
+    if lookup(store.metadata, "team", "") == "search"
+  }
+}
+
+output "vector_stores_usage_bytes" {
+  value = sum(concat([0], [for store in data.openai_vector_stores.all.vector_stores : store.usage_bytes]))
+}
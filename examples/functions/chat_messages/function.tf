@@ -0,0 +1,9 @@
+resource "openai_chat_completion" "tagline" {
+  model = "gpt-4o"
+  messages = provider::openai::chat_messages(
+    "You write taglines of at most eight words.",
+    "Write a tagline for a bakery.",
+    "Fresh from our oven to your table.",
+    "Make it funnier.",
+  )
+}
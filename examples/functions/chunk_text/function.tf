@@ -0,0 +1,8 @@
+locals {
+  handbook_chunks = provider::openai::chunk_text(file("${path.module}/handbook.md"), 800, 200)
+}
+
+data "openai_embedding" "handbook" {
+  model = "text-embedding-3-small"
+  input = local.handbook_chunks
+}
@@ -0,0 +1,11 @@
+data "openai_embedding" "greeting" {
+  model = "text-embedding-3-small"
+  input = ["How do I reset my password?"]
+
+  lifecycle {
+    postcondition {
+      condition     = provider::openai::cosine_similarity(self.embeddings[0], jsondecode(file("${path.module}/golden.json"))) > 0.95
+      error_message = "The embedding differs from the golden reference, check the model and the dimensions."
+    }
+  }
+}
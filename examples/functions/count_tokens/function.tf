@@ -0,0 +1,12 @@
+resource "openai_assistant" "support" {
+  name         = "Support"
+  model        = "gpt-4o"
+  instructions = file("${path.module}/instructions.md")
+
+  lifecycle {
+    precondition {
+      condition     = provider::openai::count_tokens("gpt-4o", file("${path.module}/instructions.md")) <= 8000
+      error_message = "The instructions of the assistant must be at most 8000 tokens."
+    }
+  }
+}
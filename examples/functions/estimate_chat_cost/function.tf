@@ -0,0 +1,18 @@
+locals {
+  summary_messages = [
+    { role = "system", content = "You summarize documents in three sentences." },
+    { role = "user", content = file("${path.module}/report.md") },
+  ]
+}
+
+resource "openai_chat_completion" "summary" {
+  model    = "gpt-4o"
+  messages = local.summary_messages
+
+  lifecycle {
+    precondition {
+      condition     = provider::openai::estimate_chat_cost("gpt-4o", local.summary_messages) < 0.05
+      error_message = "The report is too long to summarize for less than $0.05."
+    }
+  }
+}
@@ -0,0 +1,9 @@
+locals {
+  training_examples = [
+    for line in provider::openai::jsonldecode(file("${path.module}/train.jsonl")) : jsondecode(line)
+  ]
+}
+
+output "training_example_count" {
+  value = length(local.training_examples)
+}
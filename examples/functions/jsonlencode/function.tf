@@ -0,0 +1,19 @@
+locals {
+  questions = ["What is Terraform?", "What is OpenAI?"]
+}
+
+resource "openai_file" "batch_input" {
+  filename = "batch_input.jsonl"
+  purpose  = "batch"
+  content = provider::openai::jsonlencode([
+    for question in local.questions : jsonencode({
+      custom_id = md5(question)
+      method    = "POST"
+      url       = "/v1/chat/completions"
+      body = {
+        model    = "gpt-4.1-mini"
+        messages = [{ role = "user", content = question }]
+      }
+    })
+  ])
+}
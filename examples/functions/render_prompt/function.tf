@@ -0,0 +1,10 @@
+resource "openai_assistant" "support" {
+  for_each = toset(["en", "fr"])
+
+  name  = "Support (${each.key})"
+  model = "gpt-4o"
+  instructions = provider::openai::render_prompt(file("${path.module}/support.md.tmpl"), {
+    company  = "Acme"
+    language = each.key
+  })
+}
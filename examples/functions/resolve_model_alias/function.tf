@@ -0,0 +1,5 @@
+resource "openai_assistant" "support" {
+  name         = "Support"
+  model        = provider::openai::resolve_model_alias("gpt-4o")
+  instructions = "You answer the questions of the customers."
+}
@@ -0,0 +1,7 @@
+resource "openai_vector_store" "docs" {
+  name = "Documentation"
+  metadata = provider::openai::sanitize_metadata({
+    repository = var.repository
+    commit     = var.commit_message
+  }, "truncate")
+}
@@ -0,0 +1,8 @@
+resource "openai_assistant" "support" {
+  name  = "Support"
+  model = "gpt-4o"
+  instructions = join("\n\n", [
+    "You answer the questions of the customers with the context below.",
+    provider::openai::truncate_to_tokens(file("${path.module}/context.md"), "gpt-4o", 6000),
+  ])
+}
@@ -0,0 +1,5 @@
+resource "openai_file" "training" {
+  filename = "training.jsonl"
+  purpose  = "fine-tune"
+  content  = provider::openai::validate_finetune_jsonl(file("${path.module}/training.jsonl"))
+}
@@ -0,0 +1,33 @@
+locals {
+  ticket_schema = provider::openai::validate_json_schema(jsonencode({
+    type = "object"
+    properties = {
+      category = { type = "string", enum = ["billing", "bug", "question"] }
+      summary  = { type = "string" }
+    }
+    required             = ["category", "summary"]
+    additionalProperties = false
+  }), true)
+}
+
+resource "openai_file" "triage" {
+  filename = "triage.jsonl"
+  purpose  = "batch"
+  content = jsonencode({
+    custom_id = "ticket-1"
+    method    = "POST"
+    url       = "/v1/chat/completions"
+    body = {
+      model    = "gpt-4o-mini"
+      messages = [{ role = "user", content = "I was charged twice this month." }]
+      response_format = {
+        type = "json_schema"
+        json_schema = {
+          name   = "ticket"
+          strict = true
+          schema = jsondecode(local.ticket_schema)
+        }
+      }
+    }
+  })
+}
@@ -0,0 +1,11 @@
+terraform {
+  required_providers {
+    openai = {
+      source  = "registry.terraform.io/guillaume-dussault/openai"
+      version = "1.0.0-pre.2"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+provider "openai" {}
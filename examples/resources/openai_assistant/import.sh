@@ -0,0 +1,4 @@
+# The ID of the assistant, also found in the URL of the assistant in the OpenAI
+# dashboard or in the import IDs of the other OpenAI providers.
+terraform import openai_assistant.example asst_abc123
+terraform import openai_assistant.example https://platform.openai.com/assistants/asst_abc123
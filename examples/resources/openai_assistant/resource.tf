@@ -3,6 +3,33 @@ resource "openai_assistant" "example" {
   model        = "gpt-4-turbo-preview"
   instructions = "Answer every questions with a Chuck Norris joke. Be super friendly and casual."
   description  = "A friendly bot that tells jokes."
+
+  # Production assistant, not deleted by an accidental destroy.
+  deletion_protection = true
+
+  metadata = {
+    environment = "production"
+    team        = "support"
+    cost_center = "cc-1234"
+  }
+}
+
+resource "openai_vector_store" "jokes" {
+  name     = "Chuck Norris jokes"
+  file_ids = ["file-abc123"]
+}
+
+resource "openai_assistant" "file_search" {
+  name               = "Joke librarian"
+  model              = "gpt-4o"
+  instructions       = "Answer every questions with a Chuck Norris joke from the files."
+  enable_file_search = true
+
+  tool_resources = {
+    file_search = {
+      vector_store_ids = [openai_vector_store.jokes.id]
+    }
+  }
 }
 
 output "assistant_name" {
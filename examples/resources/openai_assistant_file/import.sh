@@ -0,0 +1,4 @@
+# The ID of the assistant and the ID of the file, joined with "/", ":" or ",",
+# e.g. as imported by the other OpenAI providers.
+terraform import openai_assistant_file.example asst_abc123/file-abc123
+terraform import openai_assistant_file.example asst_abc123,file-abc123
@@ -1,9 +1,9 @@
 resource "openai_assistant" "example" {
-  name             = "Test provider"
-  model            = "gpt-4-turbo-preview"
-  instructions     = "Answer every questions with a Chuck Norris joke. Be super friendly and casual."
-  description      = "A friendly bot that tells jokes."
-  enable_retrieval = true
+  name                    = "Test provider"
+  model                   = "gpt-4-turbo-preview"
+  instructions            = "Answer every questions with a Chuck Norris joke. Be super friendly and casual."
+  description             = "A friendly bot that tells jokes."
+  enable_code_interpreter = true
 }
 
 resource "openai_assistant_file" "example" {
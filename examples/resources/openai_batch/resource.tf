@@ -0,0 +1,30 @@
+locals {
+  questions = ["What is Terraform?", "What is OpenAI?"]
+}
+
+resource "openai_batch" "questions" {
+  endpoint = "/v1/chat/completions"
+  content = join("\n", [
+    for question in local.questions : jsonencode({
+      custom_id = md5(question)
+      method    = "POST"
+      url       = "/v1/chat/completions"
+      body = {
+        model    = "gpt-4.1-mini"
+        messages = [{ role = "user", content = question }]
+      }
+    })
+  ])
+
+  metadata = {
+    team = "support"
+  }
+}
+
+data "openai_batch_output" "questions" {
+  file_id = openai_batch.questions.output_file_id
+}
+
+output "answers" {
+  value = { for question in local.questions : question => data.openai_batch_output.questions.results[md5(question)].content }
+}
@@ -0,0 +1,14 @@
+resource "openai_certificate" "client" {
+  name    = "Production client certificate"
+  content = file("${path.module}/client.pem")
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "openai_certificate" "staging" {
+  name       = "Staging client certificate"
+  content    = file("${path.module}/staging.pem")
+  project_id = "proj_abc123"
+}
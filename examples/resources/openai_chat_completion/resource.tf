@@ -0,0 +1,27 @@
+resource "openai_chat_completion" "example" {
+  model = "gpt-3.5-turbo"
+
+  messages = [
+    {
+      role    = "system"
+      content = "You write short and friendly welcome messages."
+    },
+    {
+      role    = "user"
+      content = "Write a welcome message for the new staging environment."
+    },
+  ]
+
+  temperature           = 0
+  seed                  = 42
+  max_completion_tokens = 200
+
+  # Generate a new welcome message every time the environment is rebuilt.
+  triggers = {
+    environment_id = "staging-42"
+  }
+}
+
+output "welcome_message" {
+  value = openai_chat_completion.example.content
+}
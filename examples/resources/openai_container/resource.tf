@@ -0,0 +1,16 @@
+resource "openai_container" "analysis" {
+  name = "sales-analysis"
+
+  expires_after = {
+    minutes = 20
+  }
+}
+
+resource "openai_response" "report" {
+  model = "gpt-4.1"
+  input = "Summarize the quarterly sales in a table."
+
+  code_interpreter = {
+    container_id = openai_container.analysis.id
+  }
+}
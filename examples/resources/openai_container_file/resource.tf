@@ -0,0 +1,17 @@
+resource "openai_container" "analysis" {
+  name = "sales-analysis"
+}
+
+resource "openai_container_file" "sales" {
+  container_id = openai_container.analysis.id
+  source_path  = "${path.module}/sales.csv"
+}
+
+resource "openai_response" "report" {
+  model = "gpt-4.1"
+  input = "Summarize the quarterly sales of ${openai_container_file.sales.path} in a table."
+
+  code_interpreter = {
+    container_id = openai_container.analysis.id
+  }
+}
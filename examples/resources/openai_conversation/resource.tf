@@ -0,0 +1,17 @@
+resource "openai_conversation" "support" {
+  items = [
+    {
+      role    = "developer"
+      content = "You are the support assistant of Example Corp. Answer in a friendly tone."
+    },
+    {
+      role    = "assistant"
+      content = "Hi! How can I help you today?"
+    },
+  ]
+
+  metadata = {
+    environment = "staging"
+    team        = "support"
+  }
+}
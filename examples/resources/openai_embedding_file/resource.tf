@@ -0,0 +1,14 @@
+resource "openai_embedding_file" "example" {
+  input_path  = "${path.module}/faq.jsonl"
+  text_field  = "question"
+  id_field    = "id"
+  output_path = "${path.module}/faq.embeddings.jsonl"
+
+  model               = "text-embedding-3-small"
+  batch_size          = 500
+  requests_per_minute = 60
+}
+
+output "embedded_questions" {
+  value = openai_embedding_file.example.embedding_count
+}
@@ -0,0 +1,30 @@
+resource "openai_eval" "ticket_triage" {
+  name = "Ticket triage"
+
+  data_source_config = jsonencode({
+    type = "custom"
+    item_schema = {
+      type = "object"
+      properties = {
+        ticket   = { type = "string" }
+        category = { type = "string" }
+      }
+      required = ["ticket", "category"]
+    }
+    include_sample_schema = true
+  })
+
+  testing_criteria = jsonencode([
+    {
+      type      = "string_check"
+      name      = "Category matches"
+      input     = "{{ sample.output_text }}"
+      reference = "{{ item.category }}"
+      operation = "eq"
+    },
+  ])
+
+  metadata = {
+    team = "support"
+  }
+}
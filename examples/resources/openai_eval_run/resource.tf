@@ -0,0 +1,27 @@
+resource "openai_eval_run" "ticket_triage" {
+  eval_id = "eval_abc123"
+  name    = "Fine-tuned triage model"
+
+  data_source = jsonencode({
+    type  = "completions"
+    model = "ft:gpt-4o-mini-2024-07-18:acme::triage"
+    source = {
+      type = "file_id"
+      id   = "file-abc123"
+    }
+    input_messages = {
+      type = "template"
+      template = [
+        { role = "developer", content = "Categorize the support ticket." },
+        { role = "user", content = "{{ item.ticket }}" },
+      ]
+    }
+  })
+
+  lifecycle {
+    postcondition {
+      condition     = self.pass_rate >= 0.9
+      error_message = "The fine-tuned model passes less than 90% of the eval."
+    }
+  }
+}
@@ -0,0 +1,3 @@
+# The ID of the file, also found in the import IDs of the other OpenAI
+# providers.
+terraform import openai_file.example file-abc123
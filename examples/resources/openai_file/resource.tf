@@ -0,0 +1,32 @@
+resource "openai_file" "handbook" {
+  source_path = "${path.module}/handbook.pdf"
+  purpose     = "assistants"
+}
+
+resource "openai_file" "batch_input" {
+  filename = "batch_input.jsonl"
+  purpose  = "batch"
+  content = join("\n", [
+    for question in ["What is Terraform?", "What is OpenAI?"] : jsonencode({
+      custom_id = md5(question)
+      method    = "POST"
+      url       = "/v1/chat/completions"
+      body = {
+        model    = "gpt-4.1-mini"
+        messages = [{ role = "user", content = question }]
+      }
+    })
+  ])
+}
+
+resource "openai_file" "logo" {
+  filename       = "logo.png"
+  purpose        = "vision"
+  content_base64 = filebase64("${path.module}/logo.png")
+}
+
+resource "openai_file" "pricing" {
+  source_url    = "https://docs.example.com/pricing.pdf"
+  source_sha256 = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+  purpose       = "assistants"
+}
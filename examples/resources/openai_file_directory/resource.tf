@@ -0,0 +1,10 @@
+resource "openai_file_directory" "knowledge_base" {
+  path     = "${path.module}/knowledge-base"
+  patterns = ["**/*.md", "**/*.pdf"]
+  purpose  = "assistants"
+}
+
+resource "openai_vector_store" "knowledge_base" {
+  name     = "knowledge-base"
+  file_ids = values(openai_file_directory.knowledge_base.file_ids)
+}
@@ -0,0 +1,8 @@
+resource "openai_image_edit" "banner" {
+  image_path  = "${path.module}/assets/banner.png"
+  mask_path   = "${path.module}/assets/banner-mask.png"
+  prompt      = "A hot air balloon floating in the sky"
+  model       = "dall-e-2"
+  size        = "1024x1024"
+  output_path = "${path.module}/assets/banner-edited.png"
+}
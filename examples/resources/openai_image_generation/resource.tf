@@ -0,0 +1,34 @@
+resource "openai_image_generation" "avatar" {
+  prompt      = "A friendly robot mascot waving, flat illustration, pastel colors"
+  model       = "dall-e-3"
+  size        = "1024x1024"
+  output_path = "${path.module}/assets/default-avatar.png"
+}
+
+output "avatar_revised_prompt" {
+  value = openai_image_generation.avatar.revised_prompt
+}
+
+# Keep the image out of the local filesystem and upload it directly.
+resource "openai_image_generation" "placeholder" {
+  prompt = "An abstract placeholder banner, soft gradients"
+  model  = "dall-e-2"
+  size   = "512x512"
+}
+
+resource "aws_s3_object" "placeholder" {
+  bucket         = "my-assets-bucket"
+  key            = "placeholders/banner.png"
+  content_base64 = openai_image_generation.placeholder.b64_json
+  content_type   = "image/png"
+}
+
+resource "openai_image_generation" "logo" {
+  prompt        = "A minimalist logo of a paper plane"
+  model         = "gpt-image-1"
+  size          = "1024x1024"
+  quality       = "high"
+  background    = "transparent"
+  output_format = "webp"
+  output_path   = "${path.module}/assets/logo.webp"
+}
@@ -0,0 +1,6 @@
+resource "openai_image_variation" "avatar" {
+  image_path  = "${path.module}/assets/default-avatar.png"
+  model       = "dall-e-2"
+  size        = "512x512"
+  output_path = "${path.module}/assets/avatar-variation.png"
+}
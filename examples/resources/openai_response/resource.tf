@@ -0,0 +1,37 @@
+resource "openai_response" "release_notes" {
+  model        = "gpt-4o"
+  instructions = "You write concise release notes for end users."
+  input        = "Summarize these changes: ${file("${path.module}/CHANGELOG.md")}"
+
+  metadata = {
+    environment = "production"
+  }
+}
+
+# Continue the conversation from the stored response.
+resource "openai_response" "release_notes_fr" {
+  model                = "gpt-4o"
+  previous_response_id = openai_response.release_notes.id
+  input                = "Translate the release notes to French."
+}
+
+output "release_notes" {
+  value = openai_response.release_notes.output_text
+}
+
+# Let the model search the web and the product documentation.
+resource "openai_response" "faq" {
+  model = "gpt-4o"
+  input = "What are the system requirements of the latest release?"
+
+  web_search = {
+    search_context_size = "low"
+  }
+
+  file_search = {
+    vector_store_ids = ["vs_abc123"]
+    max_num_results  = 10
+  }
+
+  code_interpreter = {}
+}
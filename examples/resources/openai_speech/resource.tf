@@ -0,0 +1,17 @@
+locals {
+  ivr_prompts = {
+    welcome = "Thank you for calling. Please listen carefully, as our menu options have changed."
+    hold    = "All of our agents are currently busy. Please stay on the line."
+  }
+}
+
+resource "openai_speech" "ivr" {
+  for_each = local.ivr_prompts
+
+  input           = each.value
+  model           = "gpt-4o-mini-tts"
+  voice           = "nova"
+  instructions    = "Speak in a calm and friendly tone."
+  response_format = "wav"
+  output_path     = "${path.module}/prompts/${each.key}.wav"
+}
@@ -0,0 +1,22 @@
+resource "openai_thread" "demo" {
+  messages = [
+    {
+      role    = "user"
+      content = "Hi, I would like to know the status of my order #1234."
+    },
+    {
+      role    = "assistant"
+      content = "Sure! Your order has shipped and should arrive tomorrow."
+    },
+  ]
+
+  tool_resources = {
+    file_search = {
+      vector_store_ids = ["vs_abc123"]
+    }
+  }
+
+  metadata = {
+    environment = "demo"
+  }
+}
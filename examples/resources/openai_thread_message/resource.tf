@@ -0,0 +1,22 @@
+resource "openai_thread" "fixture" {
+  metadata = {
+    purpose = "integration-tests"
+  }
+}
+
+resource "openai_thread_message" "question" {
+  thread_id = openai_thread.fixture.id
+  role      = "user"
+  content   = "Plot the monthly sales from the attached file."
+
+  attachments = [
+    {
+      file_id = "file-abc123"
+      tools   = ["code_interpreter"]
+    },
+  ]
+
+  metadata = {
+    scenario = "sales-report"
+  }
+}
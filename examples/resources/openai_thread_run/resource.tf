@@ -0,0 +1,29 @@
+resource "openai_assistant" "support" {
+  name         = "Support"
+  model        = "gpt-4o"
+  instructions = "You answer questions about Example Corp products."
+}
+
+resource "openai_thread" "smoke_test" {
+  messages = [
+    {
+      role    = "user"
+      content = "Which products do you support?"
+    },
+  ]
+}
+
+# Run the assistant once after every change of its configuration.
+resource "openai_thread_run" "smoke_test" {
+  thread_id    = openai_thread.smoke_test.id
+  assistant_id = openai_assistant.support.id
+
+  triggers = {
+    instructions = openai_assistant.support.instructions
+    model        = openai_assistant.support.model
+  }
+}
+
+output "smoke_test_answer" {
+  value = openai_thread_run.smoke_test.messages[0].content
+}
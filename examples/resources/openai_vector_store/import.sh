@@ -0,0 +1,3 @@
+# The ID of the vector store, also found in the URL of the vector store in the
+# OpenAI dashboard or in the import IDs of the other OpenAI providers.
+terraform import openai_vector_store.example vs_abc123
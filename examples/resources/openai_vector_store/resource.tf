@@ -0,0 +1,17 @@
+resource "openai_file" "handbook" {
+  source_path = "${path.module}/handbook.pdf"
+  purpose     = "assistants"
+}
+
+resource "openai_vector_store" "handbook" {
+  name     = "Employee handbook"
+  file_ids = [openai_file.handbook.id]
+
+  expires_after = {
+    days = 30
+  }
+
+  metadata = {
+    team = "people"
+  }
+}
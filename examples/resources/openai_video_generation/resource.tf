@@ -0,0 +1,11 @@
+resource "openai_video_generation" "teaser" {
+  model       = "sora-2"
+  prompt      = "A slow pan over a foggy harbor at sunrise, fishing boats leaving the docks."
+  seconds     = 8
+  size        = "1280x720"
+  output_path = "${path.module}/teaser.mp4"
+
+  keepers = {
+    campaign = "spring-launch"
+  }
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// apiErrorGuide is the guidance given for a common OpenAI API error.
+type apiErrorGuide struct {
+	hint   string
+	docURL string
+}
+
+// apiErrorGuides maps the common OpenAI API error codes to their guidance.
+var apiErrorGuides = map[string]apiErrorGuide{
+	"invalid_api_key": {
+		hint:   "The API key was rejected by OpenAI. Set a valid key in the api_key argument of the provider or in the OPENAI_API_KEY environment variable, and check that it has not been revoked.",
+		docURL: "https://platform.openai.com/api-keys",
+	},
+	"insufficient_quota": {
+		hint:   "The organization has no quota left. Check the plan and the billing details of the organization, then run the apply again.",
+		docURL: "https://platform.openai.com/settings/organization/billing",
+	},
+	"billing_hard_limit_reached": {
+		hint:   "The organization reached the hard limit of its monthly budget. Raise the limit in the billing settings of the organization, then run the apply again.",
+		docURL: "https://platform.openai.com/settings/organization/limits",
+	},
+	"model_not_found": {
+		hint:   "The model does not exist or the project does not have access to it. Check the spelling of the model, or use the openai_api_health data source to check the models available to the API key.",
+		docURL: "https://platform.openai.com/docs/models",
+	},
+	"rate_limit_exceeded": {
+		hint:   "Too many requests or tokens were sent in a short time. Lower the parallelism of Terraform with -parallelism, or use the openai_rate_limits data source to inspect the limits of the model.",
+		docURL: "https://platform.openai.com/docs/guides/rate-limits",
+	},
+}
+
+// addAPIError adds an error diagnostic for an error returned by the client.
+// The detail is prepended to the error message. The common OpenAI errors are
+// reported with guidance on how to solve them.
+func addAPIError(diags *diag.Diagnostics, summary, detail string, err error) {
+	guide, _, ok := apiErrorGuideFor(err)
+	if !ok {
+		diags.AddError(summary, detail+err.Error())
+		return
+	}
+
+	diags.AddError(summary, detail+err.Error()+"\n\n"+guide.hint+"\n\nSee "+guide.docURL)
+}
+
+// addModelAPIError adds an error diagnostic like addAPIError, for the requests
+// sent with the model configured in the model attribute: a model_not_found
+// error is attached to the attribute.
+func addModelAPIError(diags *diag.Diagnostics, model path.Path, summary, detail string, err error) {
+	guide, code, ok := apiErrorGuideFor(err)
+	if !ok || code != "model_not_found" {
+		addAPIError(diags, summary, detail, err)
+		return
+	}
+
+	diags.AddAttributeError(model, summary, detail+err.Error()+"\n\n"+guide.hint+"\n\nSee "+guide.docURL)
+}
+
+// apiErrorGuideFor returns the guidance for the error code of an OpenAI API
+// error, when there is one.
+func apiErrorGuideFor(err error) (apiErrorGuide, string, bool) {
+	var apiErr *openai.APIError
+	if !errors.As(err, &apiErr) {
+		return apiErrorGuide{}, "", false
+	}
+
+	// The code is not always set, insufficient_quota is also reported as the
+	// type of the error.
+	code, _ := apiErr.Code.(string)
+	if code == "" {
+		code = apiErr.Type
+	}
+	if strings.HasPrefix(code, "billing_hard_limit") {
+		code = "billing_hard_limit_reached"
+	}
+
+	guide, ok := apiErrorGuides[code]
+	return guide, code, ok
+}
+
+// removeNotFound removes the resource from the state when the error of its
+// read is a 404 Not Found, and reports whether it did. The object was deleted
+// outside of Terraform, the next plan creates it again instead of failing.
+func removeNotFound(ctx context.Context, resp *resource.ReadResponse, err error, object string) bool {
+	if errorStatusCode(err) != http.StatusNotFound {
+		return false
+	}
+
+	tflog.Warn(ctx, object+" not found, removing it from the state", map[string]any{"error": err.Error()})
+	resp.State.RemoveResource(ctx)
+	return true
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+func TestAddAPIError(t *testing.T) {
+	modelNotFound := &openai.APIError{Code: "model_not_found", HTTPStatusCode: http.StatusNotFound, Message: "The model `gpt-5o` does not exist."}
+	rateLimited := &openai.APIError{Code: "rate_limit_exceeded", HTTPStatusCode: http.StatusTooManyRequests, Message: "Rate limit reached."}
+
+	tests := map[string]struct {
+		add       func(*diag.Diagnostics)
+		attribute bool
+		hint      bool
+	}{
+		"unknown error": {
+			add: func(diags *diag.Diagnostics) {
+				addAPIError(diags, "Error", "Could not create: ", errors.New("connection reset by peer"))
+			},
+		},
+		"guided error": {
+			add: func(diags *diag.Diagnostics) {
+				addAPIError(diags, "Error", "Could not create: ", rateLimited)
+			},
+			hint: true,
+		},
+		"model not found without a model attribute": {
+			add: func(diags *diag.Diagnostics) {
+				addAPIError(diags, "Error", "Could not create: ", modelNotFound)
+			},
+			hint: true,
+		},
+		"model not found": {
+			add: func(diags *diag.Diagnostics) {
+				addModelAPIError(diags, path.Root("model"), "Error", "Could not create: ", modelNotFound)
+			},
+			attribute: true,
+			hint:      true,
+		},
+		"other error with a model attribute": {
+			add: func(diags *diag.Diagnostics) {
+				addModelAPIError(diags, path.Root("model"), "Error", "Could not create: ", rateLimited)
+			},
+			hint: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			test.add(&diags)
+
+			if len(diags) != 1 {
+				t.Fatalf("expected one diagnostic, got %v", diags)
+			}
+
+			_, attribute := diags[0].(diag.DiagnosticWithPath)
+			if attribute != test.attribute {
+				t.Errorf("expected an attribute diagnostic: %t, got %t", test.attribute, attribute)
+			}
+
+			detail := diags[0].Detail()
+			if !strings.HasPrefix(detail, "Could not create: ") {
+				t.Errorf("expected the detail to be prepended, got %q", detail)
+			}
+			if hint := strings.Contains(detail, "\n\nSee https://"); hint != test.hint {
+				t.Errorf("expected a hint: %t, got %q", test.hint, detail)
+			}
+		})
+	}
+}
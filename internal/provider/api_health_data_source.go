@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &apiHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiHealthDataSource{}
+)
+
+// NewAPIHealthDataSource is a helper function to simplify the provider implementation.
+func NewAPIHealthDataSource() datasource.DataSource {
+	return &apiHealthDataSource{}
+}
+
+// apiHealthDataSource is the data source implementation.
+type apiHealthDataSource struct {
+	client *openaiClient
+}
+
+// apiHealthDataSourceModel maps the data source schema data.
+type apiHealthDataSourceModel struct {
+	BaseURL      types.String `tfsdk:"base_url"`
+	LatencyMs    types.Int64  `tfsdk:"latency_ms"`
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	ModelCount   types.Int64  `tfsdk:"model_count"`
+}
+
+// apiHealthModelsResponse is the body of a response listing the models.
+type apiHealthModelsResponse struct {
+	Data []struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+// Metadata returns the data source type name.
+func (d *apiHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_health"
+}
+
+// Schema defines the schema for the data source.
+func (d *apiHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks that the OpenAI API can be reached with the configured API key, by listing the models. Reading the data source fails with a description of the problem otherwise, so plans against invalid credentials fail early.",
+		Attributes: map[string]schema.Attribute{
+			"base_url": schema.StringAttribute{
+				Description: "Base URL of the API the check was sent to.",
+				Computed:    true,
+			},
+			"latency_ms": schema.Int64Attribute{
+				Description: "Duration of the check, in milliseconds.",
+				Computed:    true,
+			},
+			"organization": schema.StringAttribute{
+				Description: "ID of the organization the API key belongs to, as reported by the API.",
+				Computed:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "ID of the project the API key belongs to, as reported by the API. Not reported for every key.",
+				Computed:    true,
+			},
+			"model_count": schema.Int64Attribute{
+				Description: "Number of models available to the API key.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *apiHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *apiHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data apiHealthDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bypass the read cache, the latency of a cached response means nothing.
+	start := time.Now()
+
+	var models apiHealthModelsResponse
+	header, err := d.client.doJSONHeader(withFreshReads(ctx), http.MethodGet, "/models", nil, &models)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"OpenAI API health check failed",
+			fmt.Sprintf("Could not list the models of %s: %s\n\n%s", d.client.baseURL, err.Error(), apiHealthHint(errorStatusCode(err))),
+		)
+		return
+	}
+
+	data.BaseURL = types.StringValue(d.client.baseURL)
+	data.LatencyMs = types.Int64Value(time.Since(start).Milliseconds())
+	data.Organization = types.StringNull()
+	if organization := header.Get("Openai-Organization"); organization != "" {
+		data.Organization = types.StringValue(organization)
+	}
+	data.Project = types.StringNull()
+	if project := header.Get("Openai-Project"); project != "" {
+		data.Project = types.StringValue(project)
+	}
+	data.ModelCount = types.Int64Value(int64(len(models.Data)))
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// apiHealthHint describes the likely cause of a failed health check from the
+// HTTP status code of the response.
+func apiHealthHint(statusCode int) string {
+	switch statusCode {
+	case 0:
+		return "The API could not be reached, check the network access to the API."
+	case http.StatusUnauthorized:
+		return "The API key is invalid or has been revoked, check the api_key of the provider or the OPENAI_API_KEY environment variable."
+	case http.StatusForbidden:
+		return "The API key is not allowed to list the models, check its permissions and the region the API is called from."
+	case http.StatusNotFound:
+		return "The endpoint was not found, check that the base URL points to the OpenAI API."
+	case http.StatusTooManyRequests:
+		return "The API key is rate limited or has exceeded its quota."
+	default:
+		return fmt.Sprintf("The API responded with an unexpected HTTP status %d.", statusCode)
+	}
+}
@@ -6,18 +6,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	openai "github.com/sashabaranov/go-openai"
 	"golang.org/x/exp/slices"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &assistantDataSource{}
-	_ datasource.DataSourceWithConfigure = &assistantDataSource{}
+	_ datasource.DataSource                   = &assistantDataSource{}
+	_ datasource.DataSourceWithConfigure      = &assistantDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &assistantDataSource{}
 )
 
 // NewAssistantDataSource is a helper function to simplify the provider implementation.
@@ -27,18 +31,20 @@ func NewAssistantDataSource() datasource.DataSource {
 
 // assistantDataSource is the data source implementation.
 type assistantDataSource struct {
-	client *openai.Client
+	client *openaiClient
 }
 
 // assistantDataSourceModel maps the data source schema data.
 type assistantDataSourceModel struct {
-	ID                    types.String `tfsdk:"id"`
-	Name                  types.String `tfsdk:"name"`
-	Description           types.String `tfsdk:"description"`
-	Model                 types.String `tfsdk:"model"`
-	Instructions          types.String `tfsdk:"instructions"`
-	EnableRetrieval       types.Bool   `tfsdk:"enable_retrieval"`
-	EnableCodeInterpreter types.Bool   `tfsdk:"enable_code_interpreter"`
+	ID                    types.String        `tfsdk:"id"`
+	Name                  types.String        `tfsdk:"name"`
+	Description           types.String        `tfsdk:"description"`
+	Model                 types.String        `tfsdk:"model"`
+	Instructions          types.String        `tfsdk:"instructions"`
+	EnableFileSearch      types.Bool          `tfsdk:"enable_file_search"`
+	EnableCodeInterpreter types.Bool          `tfsdk:"enable_code_interpreter"`
+	ToolResources         *toolResourcesModel `tfsdk:"tool_resources"`
+	Metadata              types.Map           `tfsdk:"metadata"`
 }
 
 // Metadata returns the data source type name.
@@ -49,15 +55,17 @@ func (d *assistantDataSource) Metadata(_ context.Context, req datasource.Metadat
 // Schema defines the schema for the data source.
 func (d *assistantDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a OpenAI assistant.",
+		MarkdownDescription: "Fetches a OpenAI assistant, by `id` or by `name`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "ID of the Assistant.",
-				Required:    true,
+				MarkdownDescription: "ID of the Assistant. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the assistant.",
-				Computed:    true,
+				MarkdownDescription: "Name of the assistant. Exactly one of `id` or `name` must be set, the name must match exactly one assistant of the organization, or of the project when `project_id` is set on the provider.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the assistant.",
@@ -71,14 +79,20 @@ func (d *assistantDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "Instructions for the assistant. Use this attribute to guide the personality of the assistant and define its goals. Instructions are similar to system messages in the Chat Completions API.",
 				Computed:    true,
 			},
-			"enable_retrieval": schema.BoolAttribute{
-				Description: "Retrieval enables the assistant with knowledge from files that you or your users upload.",
-				Computed:    true,
+			"enable_file_search": schema.BoolAttribute{
+				MarkdownDescription: "File Search enables the assistant with knowledge from the files of the vector stores of `tool_resources`.",
+				Computed:            true,
 			},
 			"enable_code_interpreter": schema.BoolAttribute{
 				Description: "Code Interpreter enables the assistant to write and run code. This tool can process files with diverse data and formatting, and generate files such as graphs.",
 				Computed:    true,
 			},
+			"tool_resources": toolResourcesDataSourceSchema("Resources made available to the tools of the assistant."),
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the assistant.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -89,11 +103,11 @@ func (d *assistantDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*openai.Client)
+	client, ok := req.ProviderData.(*openaiClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *openai.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -102,6 +116,31 @@ func (d *assistantDataSource) Configure(_ context.Context, req datasource.Config
 	d.client = client
 }
 
+// ValidateConfig ensures exactly one of id or name is set.
+func (d *assistantDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config assistantDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ID.IsNull() && !config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Conflicting assistant lookup",
+			"Only one of id or name can be set.",
+		)
+	}
+
+	if config.ID.IsNull() && config.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing assistant lookup",
+			"One of id or name must be set.",
+		)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *assistantDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data assistantDataSourceModel
@@ -112,26 +151,61 @@ func (d *assistantDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	assistant, err := d.client.RetrieveAssistant(ctx, data.ID.ValueString())
+	if data.ID.IsNull() {
+		matches, err := d.client.findAssistantsByName(ctx, data.Name.ValueString())
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Unable to list OpenAI assistants", "", err)
+			return
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Assistant not found",
+				fmt.Sprintf("No assistant is named %q.", data.Name.ValueString()),
+			)
+			return
+		case 1:
+			data.ID = types.StringValue(matches[0].ID)
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, match := range matches {
+				ids = append(ids, match.ID)
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Multiple assistants found",
+				fmt.Sprintf("%d assistants are named %q: %s. Use id to select one of them.", len(matches), data.Name.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	var assistant assistantObject
+	err := d.client.doJSON(ctx, http.MethodGet, "/assistants/"+data.ID.ValueString(), nil, &assistant)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to read OpenAI assistant",
-			err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Unable to read OpenAI assistant", "", err)
 		return
 	}
 
 	data.ID = types.StringValue(assistant.ID)
-	data.Name = types.StringValue(*assistant.Name)
+	data.Name = types.StringValue(assistant.Name)
 	data.Model = types.StringValue(assistant.Model)
-	data.Instructions = types.StringValue(*assistant.Instructions)
-	data.EnableRetrieval = types.BoolValue(slices.Contains(assistant.Tools, openai.AssistantTool{Type: openai.AssistantToolTypeRetrieval}))
-	data.EnableCodeInterpreter = types.BoolValue(slices.Contains(assistant.Tools, openai.AssistantTool{Type: openai.AssistantToolTypeCodeInterpreter}))
+	data.Instructions = types.StringValue(assistant.Instructions)
+	data.EnableFileSearch = types.BoolValue(slices.Contains(assistant.Tools, assistantTool{Type: "file_search"}))
+	data.EnableCodeInterpreter = types.BoolValue(slices.Contains(assistant.Tools, assistantTool{Type: "code_interpreter"}))
 
 	if assistant.Description != nil {
 		data.Description = types.StringValue(*assistant.Description)
 	}
 
+	data.ToolResources, diags = toolResourcesDataFromAPI(ctx, assistant.ToolResources)
+	resp.Diagnostics.Append(diags...)
+
+	data.Metadata, diags = types.MapValueFrom(ctx, types.StringType, assistant.userMetadata())
+	resp.Diagnostics.Append(diags...)
+
 	// Set state
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -139,3 +213,31 @@ func (d *assistantDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 }
+
+// findAssistantsByName lists all the assistants and returns the ones with the
+// given name.
+func (c *openaiClient) findAssistantsByName(ctx context.Context, name string) ([]assistantObject, error) {
+	var matches []assistantObject
+
+	query := url.Values{"limit": {"100"}}
+	for {
+		var page struct {
+			Data    []assistantObject `json:"data"`
+			HasMore bool              `json:"has_more"`
+			LastID  string            `json:"last_id"`
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/assistants?"+query.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+
+		for _, assistant := range page.Data {
+			if assistant.Name == name {
+				matches = append(matches, assistant)
+			}
+		}
+		if !page.HasMore || page.LastID == "" {
+			return matches, nil
+		}
+		query.Set("after", page.LastID)
+	}
+}
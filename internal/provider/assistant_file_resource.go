@@ -7,9 +7,11 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,9 +20,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &assistantFileResource{}
-	_ resource.ResourceWithConfigure   = &assistantFileResource{}
-	_ resource.ResourceWithImportState = &assistantFileResource{}
+	_ resource.Resource                 = &assistantFileResource{}
+	_ resource.ResourceWithConfigure    = &assistantFileResource{}
+	_ resource.ResourceWithImportState  = &assistantFileResource{}
+	_ resource.ResourceWithModifyPlan   = &assistantFileResource{}
+	_ resource.ResourceWithUpgradeState = &assistantFileResource{}
 )
 
 // NewAssistantFileResource is a helper function to simplify the provider implementation.
@@ -30,15 +34,18 @@ func NewAssistantFileResource() resource.Resource {
 
 // assistantFileResource is the resource implementation.
 type assistantFileResource struct {
-	client *openai.Client
+	client *openaiClient
 }
 
 // assistantFileResourceModel maps the resource schema data.
 type assistantFileResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Filename    types.String `tfsdk:"filename"`
-	AssistantID types.String `tfsdk:"assistant_id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID            types.String   `tfsdk:"id"`
+	Filename      types.String   `tfsdk:"filename"`
+	ContentSHA256 types.String   `tfsdk:"content_sha256"`
+	AssistantID   types.String   `tfsdk:"assistant_id"`
+	CreatedAt     types.Int64    `tfsdk:"created_at"`
+	Project       types.String   `tfsdk:"project"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -47,9 +54,11 @@ func (r *assistantFileResource) Metadata(_ context.Context, req resource.Metadat
 }
 
 // Schema defines the schema for the resource.
-func (r *assistantFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *assistantFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Provides an OpenAI assistant file resource.",
+		Version:            1,
+		Description:        "Provides an OpenAI assistant file resource.",
+		DeprecationMessage: "The assistant files of the v1 Assistants API do not exist in the v2 Assistants API. Upload the file with openai_file, add it to an openai_vector_store, and attach the vector store to the file_search tool_resources of openai_assistant instead.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "ID of the file.",
@@ -65,6 +74,10 @@ func (r *assistantFileResource) Schema(_ context.Context, _ resource.SchemaReque
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the content of the file. A change of the checksum uploads the file again.",
+				Computed:    true,
+			},
 			"assistant_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The ID of the assistant to which this file will be included.",
@@ -72,11 +85,38 @@ func (r *assistantFileResource) Schema(_ context.Context, _ resource.SchemaReque
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"last_updated": schema.StringAttribute{
-				Description: "Timestamp of the last Terraform update of the assistant.",
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the upload of the file.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the file is uploaded to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// UpgradeState upgrades the state stored with the previous schema versions.
+func (r *assistantFileResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// The local last_updated timestamp is replaced by the created_at of the
+		// API, set by the next refresh.
+		0: renameAttributesStateUpgrader(map[string]string{"last_updated": ""}),
 	}
 }
 
@@ -86,12 +126,12 @@ func (r *assistantFileResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*openai.Client)
+	client, ok := req.ProviderData.(*openaiClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *openai.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -99,6 +139,42 @@ func (r *assistantFileResource) Configure(_ context.Context, req resource.Config
 	r.client = client
 }
 
+// ModifyPlan computes the checksum of the content of the file, so a change of
+// the local file uploads it again.
+func (r *assistantFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan assistantFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.Filename.IsUnknown() {
+		return
+	}
+
+	// The files uploaded before the checksum was recorded are not uploaded
+	// again, their checksum is only recorded.
+	if !req.State.Raw.IsNull() {
+		var state assistantFileResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.ContentSHA256.IsNull() {
+			if checksum, err := localFilesSHA256(plan.Filename.ValueString()); err == nil {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), checksum)...)
+			}
+			return
+		}
+	}
+
+	modifyPlanLocalFilesChecksum(ctx, req, resp, path.Root("content_sha256"), plan.Filename.ValueString())
+}
+
 // Create a new resource.
 func (r *assistantFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -109,6 +185,17 @@ func (r *assistantFileResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
 	fileContent, err := os.ReadFile(plan.Filename.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -134,12 +221,16 @@ func (r *assistantFileResource) Create(ctx context.Context, req resource.CreateR
 		Purpose: "assistants",
 	}
 
-	file, err := r.client.CreateFileBytes(ctx, fileRequest)
+	// Files have no metadata, a file uploaded by a request whose response is
+	// lost is recognized by its name and size instead.
+	started := time.Now()
+	file, err := createOnce(ctx, func(ctx context.Context) (openai.File, error) {
+		return r.client.CreateFileBytes(ctx, fileRequest)
+	}, func(ctx context.Context) (openai.File, bool, error) {
+		return r.client.findUploadedFile(ctx, fileRequest.Name, int64(len(fileRequest.Bytes)), string(fileRequest.Purpose), started)
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating file",
-			"Could not create assistant file, unexpected error: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error creating file", "Could not create assistant file, unexpected error: ", err)
 		return
 	}
 
@@ -147,16 +238,27 @@ func (r *assistantFileResource) Create(ctx context.Context, req resource.CreateR
 		FileID: file.ID,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating assistant file",
-			"Could not create assistant file, unexpected error: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error creating assistant file", "Could not create assistant file, unexpected error: ", err)
 		return
 	}
 
+	// Wait for the assistant file to be readable, the resources depending on
+	// it would otherwise fail with a 404 Not Found.
+	err = retryNotFound(ctx, func(ctx context.Context) error {
+		_, err := r.client.RetrieveAssistantFile(ctx, plan.AssistantID.ValueString(), file.ID)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Assistant file not readable yet",
+			"The assistant file "+file.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
+
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(file.ID)
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.ContentSHA256 = types.StringValue(sha256Hex(fileContent))
+	plan.CreatedAt = types.Int64Value(file.CreatedAt)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -176,28 +278,39 @@ func (r *assistantFileResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
 	// Get refreshed value from OpenAI
-	_, err := r.client.GetFile(ctx, state.ID.ValueString())
+	file, err := r.client.GetFile(ctx, state.ID.ValueString())
+	if removeNotFound(ctx, resp, err, "OpenAI file "+state.ID.ValueString()) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading OpenAI file",
-			"Could not read OpenAI file ID "+state.ID.ValueString()+": "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI file", "Could not read OpenAI file ID "+state.ID.ValueString()+": ", err)
 		return
 	}
 
 	// Get refreshed value from OpenAI
 	assistantFile, err := r.client.RetrieveAssistantFile(ctx, state.AssistantID.ValueString(), state.ID.ValueString())
+	if removeNotFound(ctx, resp, err, "OpenAI assistant file "+state.ID.ValueString()) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading OpenAI assistant file",
-			"Could not read OpenAI assistant file ID "+state.ID.ValueString()+": "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI assistant file", "Could not read OpenAI assistant file ID "+state.ID.ValueString()+": ", err)
 		return
 	}
 
 	state.ID = types.StringValue(assistantFile.ID)
 	state.AssistantID = types.StringValue(assistantFile.AssistantID)
+	state.CreatedAt = types.Int64Value(file.CreatedAt)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -216,8 +329,6 @@ func (r *assistantFileResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -234,28 +345,44 @@ func (r *assistantFileResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
 	// Delete existing assistant file
 	err := r.client.DeleteAssistantFile(ctx, state.AssistantID.ValueString(), state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting OpenAI assistant file",
-			"Could not delete assistant file, unexpected error: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI assistant file", "Could not delete assistant file, unexpected error: ", err)
 		return
 	}
 
 	// Delete existing file
 	err = r.client.DeleteFile(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting OpenAI file",
-			"Could not delete assistant file, unexpected error: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI file", "Could not delete assistant file, unexpected error: ", err)
 		return
 	}
 }
 
 func (r *assistantFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Accept the import IDs of the other OpenAI providers, see
+	// assistantIDPattern and fileIDPattern.
+	assistantID := assistantIDPattern.FindString(req.ID)
+	fileID := fileIDPattern.FindString(req.ID)
+	if assistantID == "" || fileID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: assistant_id/file_id, e.g. asst_abc123/file-abc123. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("assistant_id"), assistantID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fileID)...)
 }
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAssistantFileResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	r := NewAssistantFileResource()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	tests := map[string]struct {
+		importID string
+		err      bool
+	}{
+		"slash":          {importID: "asst_abc123/file-def456"},
+		"comma":          {importID: "asst_abc123,file-def456"},
+		"colon":          {importID: "asst_abc123:file-def456"},
+		"file first":     {importID: "file-def456,asst_abc123"},
+		"file only":      {importID: "file-def456", err: true},
+		"assistant only": {importID: "asst_abc123", err: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: test.importID}, &resp)
+
+			if test.err {
+				if !resp.Diagnostics.HasError() {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var assistantID, fileID types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("assistant_id"), &assistantID)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &fileID)...)
+			if assistantID.ValueString() != "asst_abc123" || fileID.ValueString() != "file-def456" {
+				t.Errorf("expected asst_abc123 and file-def456, got %s and %s", assistantID, fileID)
+			}
+		})
+	}
+}
@@ -3,26 +3,45 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
-
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	openai "github.com/sashabaranov/go-openai"
 	"golang.org/x/exp/slices"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &assistantResource{}
-	_ resource.ResourceWithConfigure   = &assistantResource{}
-	_ resource.ResourceWithImportState = &assistantResource{}
+	_ resource.Resource                 = &assistantResource{}
+	_ resource.ResourceWithConfigure    = &assistantResource{}
+	_ resource.ResourceWithModifyPlan   = &assistantResource{}
+	_ resource.ResourceWithImportState  = &assistantResource{}
+	_ resource.ResourceWithUpgradeState = &assistantResource{}
 )
 
+// modelSnapshotPattern matches the suffix of the model snapshots, e.g. the
+// -2024-08-06 of gpt-4o-2024-08-06 or the -0613 of gpt-4-0613.
+var modelSnapshotPattern = regexp.MustCompile(`^-(\d{4}-\d{2}-\d{2}|\d{4})$`)
+
+// defaultAssistantSampling is the default temperature and top_p of the
+// assistants.
+const defaultAssistantSampling = 1.0
+
 // NewAssistantResource is a helper function to simplify the provider implementation.
 func NewAssistantResource() resource.Resource {
 	return &assistantResource{}
@@ -30,19 +49,62 @@ func NewAssistantResource() resource.Resource {
 
 // assistantResource is the resource implementation.
 type assistantResource struct {
-	client *openai.Client
+	client *openaiClient
 }
 
 // assistantResourceModel maps the resource schema data.
 type assistantResourceModel struct {
-	ID                    types.String `tfsdk:"id"`
-	Name                  types.String `tfsdk:"name"`
-	Description           types.String `tfsdk:"description"`
-	Model                 types.String `tfsdk:"model"`
-	Instructions          types.String `tfsdk:"instructions"`
-	EnableRetrieval       types.Bool   `tfsdk:"enable_retrieval"`
-	EnableCodeInterpreter types.Bool   `tfsdk:"enable_code_interpreter"`
-	LastUpdated           types.String `tfsdk:"last_updated"`
+	ID                    types.String        `tfsdk:"id"`
+	Name                  types.String        `tfsdk:"name"`
+	Description           types.String        `tfsdk:"description"`
+	Model                 types.String        `tfsdk:"model"`
+	Instructions          types.String        `tfsdk:"instructions"`
+	Temperature           types.Float64       `tfsdk:"temperature"`
+	TopP                  types.Float64       `tfsdk:"top_p"`
+	EnableFileSearch      types.Bool          `tfsdk:"enable_file_search"`
+	EnableCodeInterpreter types.Bool          `tfsdk:"enable_code_interpreter"`
+	ToolResources         *toolResourcesModel `tfsdk:"tool_resources"`
+	Metadata              types.Map           `tfsdk:"metadata"`
+	DeletionProtection    types.Bool          `tfsdk:"deletion_protection"`
+	CreatedAt             types.Int64         `tfsdk:"created_at"`
+	Project               types.String        `tfsdk:"project"`
+	Timeouts              timeouts.Value      `tfsdk:"timeouts"`
+}
+
+// assistantRequest is the body of a request creating or updating an
+// assistant. The SDK only supports the v1 Assistants API, without the
+// file_search tool and the tool resources.
+type assistantRequest struct {
+	Name          *string           `json:"name,omitempty"`
+	Description   *string           `json:"description,omitempty"`
+	Model         string            `json:"model"`
+	Instructions  *string           `json:"instructions,omitempty"`
+	Temperature   *float64          `json:"temperature,omitempty"`
+	TopP          *float64          `json:"top_p,omitempty"`
+	Tools         []assistantTool   `json:"tools"`
+	ToolResources *toolResources    `json:"tool_resources,omitempty"`
+	Metadata      map[string]string `json:"metadata"`
+}
+
+// assistantTool is a tool of an assistant. Only the type of the tools is
+// managed, the function tools are ignored.
+type assistantTool struct {
+	Type string `json:"type"`
+}
+
+// assistantObject is an assistant returned by the Assistants API.
+type assistantObject struct {
+	ID            string            `json:"id"`
+	Name          string            `json:"name"`
+	Description   *string           `json:"description"`
+	Model         string            `json:"model"`
+	Instructions  string            `json:"instructions"`
+	Temperature   *float64          `json:"temperature"`
+	TopP          *float64          `json:"top_p"`
+	Tools         []assistantTool   `json:"tools"`
+	ToolResources *toolResources    `json:"tool_resources"`
+	Metadata      map[string]string `json:"metadata"`
+	CreatedAt     int64             `json:"created_at"`
 }
 
 // Metadata returns the resource type name.
@@ -51,8 +113,9 @@ func (r *assistantResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 // Schema defines the schema for the resource.
-func (r *assistantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *assistantResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     2,
 		Description: "Provides an OpenAI assistant resource.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -65,10 +128,16 @@ func (r *assistantResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"name": schema.StringAttribute{
 				Description: "Name of the assistant.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the assistant.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(512),
+				},
 			},
 			"model": schema.StringAttribute{
 				MarkdownDescription: "Model to use for this assistant. Valid options are `gpt-4-turbo-preview`, `gpt-4`, `gpt-3.5-turbo-16k`, `gpt-3.5-turbo-0125`, `gpt-3.5-turbo`, `gpt-4-1106-preview`, `gpt-4-0125-preview`, `gpt-4-0613`, `gpt-3.5-turbo-1106`, `gpt-3.5-turbo-0613` or any other models currently supported by OpenAI assistant.",
@@ -77,12 +146,29 @@ func (r *assistantResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"instructions": schema.StringAttribute{
 				Description: "Instructions for the assistant. Use this attribute to guide the personality of the assistant and define its goals. Instructions are similar to system messages in the Chat Completions API.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256000),
+				},
 			},
-			"enable_retrieval": schema.BoolAttribute{
-				Description: "Retrieval enables the assistant with knowledge from files that you or your users upload.",
+			"temperature": schema.Float64Attribute{
+				Description: "Sampling temperature to use, between 0 and 2. Higher values make the output more random, lower values make it more focused and deterministic. Defaults to 1.",
 				Optional:    true,
-				Computed:    true,
-				Default:     booldefault.StaticBool(false),
+				Validators: []validator.Float64{
+					float64validator.Between(0, 2),
+				},
+			},
+			"top_p": schema.Float64Attribute{
+				Description: "Nucleus sampling, the model only considers the tokens comprising the top_p probability mass. Defaults to 1.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"enable_file_search": schema.BoolAttribute{
+				MarkdownDescription: "File Search enables the assistant with knowledge from the files of the vector stores of `tool_resources`. Replaces the `enable_retrieval` attribute of the v1 Assistants API.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"enable_code_interpreter": schema.BoolAttribute{
 				Description: "Code Interpreter enables the assistant to write and run code. This tool can process files with diverse data and formatting, and generate files such as graphs.",
@@ -90,11 +176,58 @@ func (r *assistantResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
-			"last_updated": schema.StringAttribute{
-				Description: "Timestamp of the last Terraform update of the assistant.",
+			"tool_resources": toolResourcesSchema("Resources made available to the tools of the assistant."),
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the assistant, up to 15 as the provider uses one of the 16 pairs accepted by OpenAI.",
+				ElementType: types.StringType,
+				Optional:    true,
+				// The create marker takes one of the pairs.
+				Validators: append(metadataValidators(),
+					mapvalidator.SizeAtMost(15),
+					mapvalidator.KeysAre(stringvalidator.NoneOf(createMarkerKey)),
+				),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the assistant is protected from deletion. When `true`, destroying or replacing the assistant fails until it is set to `false` and applied. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the assistant.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the assistant, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// UpgradeState upgrades the state stored with the previous schema versions.
+func (r *assistantResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// The retrieval tool of the v1 Assistants API is the file_search tool
+		// of the v2 API, the tool resources are new and left null.
+		0: renameAttributesStateUpgrader(map[string]string{"enable_retrieval": "enable_file_search", "last_updated": ""}),
+		// The local last_updated timestamp is replaced by the created_at of
+		// the API, set by the next refresh.
+		1: renameAttributesStateUpgrader(map[string]string{"last_updated": ""}),
 	}
 }
 
@@ -104,12 +237,12 @@ func (r *assistantResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*openai.Client)
+	client, ok := req.ProviderData.(*openaiClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *openai.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -118,6 +251,11 @@ func (r *assistantResource) Configure(_ context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+// ModifyPlan checks the model is available to the API key.
+func (r *assistantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.modifyPlanModel(ctx, req, resp)
+}
+
 // Create a new resource.
 func (r *assistantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -128,35 +266,60 @@ func (r *assistantResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	// Create new assistant
-	assistantRequest := openai.AssistantRequest{
-		Name:         plan.Name.ValueStringPointer(),
-		Description:  plan.Description.ValueStringPointer(),
-		Model:        plan.Model.ValueString(),
-		Instructions: plan.Instructions.ValueStringPointer(),
-		Tools:        []openai.AssistantTool{},
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if plan.EnableRetrieval.ValueBool() {
-		assistantRequest.Tools = append(assistantRequest.Tools, openai.AssistantTool{Type: openai.AssistantToolTypeRetrieval})
-	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
 
-	if plan.EnableCodeInterpreter.ValueBool() {
-		assistantRequest.Tools = append(assistantRequest.Tools, openai.AssistantTool{Type: openai.AssistantToolTypeCodeInterpreter})
+	// Create new assistant
+	assistantRequest, diags := plan.request(ctx, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	assistant, err := r.client.CreateAssistant(ctx, assistantRequest)
+	// Mark the assistant, so a create request whose response is lost does not
+	// leave a duplicate behind.
+	marker, err := newCreateMarker()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating assistant",
-			"Could not create assistant, unexpected error: "+err.Error(),
+			"Could not generate the create marker, unexpected error: "+err.Error(),
 		)
 		return
 	}
+	assistantRequest.Metadata[createMarkerKey] = marker
+
+	assistant, err := createOnce(ctx, func(ctx context.Context) (assistantObject, error) {
+		var assistant assistantObject
+		err := r.client.doJSON(ctx, http.MethodPost, "/assistants", assistantRequest, &assistant)
+		return assistant, err
+	}, func(ctx context.Context) (assistantObject, bool, error) {
+		return r.client.findAssistant(ctx, marker)
+	})
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error creating assistant", "Could not create assistant, unexpected error: ", err)
+		return
+	}
+
+	// Wait for the assistant to be readable, the resources depending on it
+	// would otherwise fail with a 404 Not Found.
+	if err := r.client.waitReadable(ctx, "/assistants/"+assistant.ID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Assistant not readable yet",
+			"The assistant "+assistant.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(assistant.ID)
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.CreatedAt = types.Int64Value(assistant.CreatedAt)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -176,25 +339,33 @@ func (r *assistantResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
 	// Get refreshed assistant value from OpenAI
-	assistant, err := r.client.RetrieveAssistant(ctx, state.ID.ValueString())
+	var assistant assistantObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/assistants/"+state.ID.ValueString(), nil, &assistant)
+	if removeNotFound(ctx, resp, err, "OpenAI assistant "+state.ID.ValueString()) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading OpenAI assistant",
-			"Could not read OpenAI assistant ID "+state.ID.ValueString()+": "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI assistant", "Could not read OpenAI assistant ID "+state.ID.ValueString()+": ", err)
 		return
 	}
 
-	state.ID = types.StringValue(assistant.ID)
-	state.Name = types.StringValue(*assistant.Name)
-	state.Model = types.StringValue(assistant.Model)
-	state.Instructions = types.StringValue(*assistant.Instructions)
-	state.EnableRetrieval = types.BoolValue(slices.Contains(assistant.Tools, openai.AssistantTool{Type: openai.AssistantToolTypeRetrieval}))
-	state.EnableCodeInterpreter = types.BoolValue(slices.Contains(assistant.Tools, openai.AssistantTool{Type: openai.AssistantToolTypeCodeInterpreter}))
+	resp.Diagnostics.Append(state.refresh(ctx, assistant)...)
 
-	if assistant.Description != nil {
-		state.Description = types.StringValue(*assistant.Description)
+	// The deletion protection is only known to Terraform, the imported
+	// assistants are not protected.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
 	}
 
 	// Set refreshed state
@@ -214,43 +385,45 @@ func (r *assistantResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	assistantRequest := openai.AssistantRequest{
-		Name:         plan.Name.ValueStringPointer(),
-		Description:  plan.Description.ValueStringPointer(),
-		Model:        plan.Model.ValueString(),
-		Instructions: plan.Instructions.ValueStringPointer(),
-		Tools:        []openai.AssistantTool{},
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if plan.EnableRetrieval.ValueBool() {
-		assistantRequest.Tools = append(assistantRequest.Tools, openai.AssistantTool{Type: openai.AssistantToolTypeRetrieval})
-	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
 
-	if plan.EnableCodeInterpreter.ValueBool() {
-		assistantRequest.Tools = append(assistantRequest.Tools, openai.AssistantTool{Type: openai.AssistantToolTypeCodeInterpreter})
+	assistantRequest, diags := plan.request(ctx, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Update existing assistant
-	_, err := r.client.ModifyAssistant(ctx, plan.ID.ValueString(), assistantRequest)
+	err := r.client.doJSON(ctx, http.MethodPost, "/assistants/"+plan.ID.ValueString(), assistantRequest, nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating OpenAI Assistant",
-			"Could not update assistant, unexpected error: "+err.Error(),
-		)
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error Updating OpenAI Assistant", "Could not update assistant, unexpected error: ", err)
 		return
 	}
 
-	// Fetch updated items from GetAssistant as UpdateAssistant items are not
-	// populated.
-	_, err = r.client.RetrieveAssistant(ctx, plan.ID.ValueString())
+	// Read the updated assistant back, so the state holds the values applied
+	// by OpenAI rather than the planned ones.
+	var assistant assistantObject
+	err = retryNotFound(ctx, func(ctx context.Context) error {
+		return r.client.doJSON(ctx, http.MethodGet, "/assistants/"+plan.ID.ValueString(), nil, &assistant)
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading OpenAI Assistant",
-			"Could not read OpenAI assistant ID "+plan.ID.ValueString()+": "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI Assistant", "Could not read OpenAI assistant ID "+plan.ID.ValueString()+": ", err)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refresh(ctx, assistant)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -268,18 +441,175 @@ func (r *assistantResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_protection"),
+			"Assistant protected from deletion",
+			"The assistant "+state.ID.ValueString()+" cannot be deleted while deletion_protection is true. "+
+				"Set deletion_protection to false and apply the change before destroying or replacing the assistant.",
+		)
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
 	// Delete existing assistant
-	_, err := r.client.DeleteAssistant(ctx, state.ID.ValueString())
+	err := r.client.doJSON(ctx, http.MethodDelete, "/assistants/"+state.ID.ValueString(), nil, nil)
 	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI Assistant", "Could not delete assistant, unexpected error: ", err)
+		return
+	}
+}
+
+func (r *assistantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept the import IDs of the other OpenAI providers, see
+	// assistantIDPattern.
+	assistantID := assistantIDPattern.FindString(req.ID)
+	if assistantID == "" {
 		resp.Diagnostics.AddError(
-			"Error Deleting OpenAI Assistant",
-			"Could not delete assistant, unexpected error: "+err.Error(),
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: assistant_id, e.g. asst_abc123. Got: %q", req.ID),
 		)
 		return
 	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), assistantID)...)
 }
 
-func (r *assistantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// request returns the body of a request creating or updating the assistant.
+// With clear set, the tool resources removed from the configuration are
+// removed from the assistant, and the sampling parameters are reset.
+func (m *assistantResourceModel) request(ctx context.Context, clear bool) (assistantRequest, diag.Diagnostics) {
+	assistantRequest := assistantRequest{
+		Name:         m.Name.ValueStringPointer(),
+		Description:  m.Description.ValueStringPointer(),
+		Model:        m.Model.ValueString(),
+		Instructions: m.Instructions.ValueStringPointer(),
+		Temperature:  m.Temperature.ValueFloat64Pointer(),
+		TopP:         m.TopP.ValueFloat64Pointer(),
+		Tools:        []assistantTool{},
+	}
+
+	// The sampling parameters removed from the configuration are reset to
+	// their default, they would otherwise keep their previous value.
+	defaultSampling := defaultAssistantSampling
+	if clear && assistantRequest.Temperature == nil {
+		assistantRequest.Temperature = &defaultSampling
+	}
+	if clear && assistantRequest.TopP == nil {
+		assistantRequest.TopP = &defaultSampling
+	}
+
+	// A description removed from the configuration is cleared, it would
+	// otherwise be kept as well.
+	noDescription := ""
+	if clear && assistantRequest.Description == nil {
+		assistantRequest.Description = &noDescription
+	}
+
+	if m.EnableFileSearch.ValueBool() {
+		assistantRequest.Tools = append(assistantRequest.Tools, assistantTool{Type: "file_search"})
+	}
+
+	if m.EnableCodeInterpreter.ValueBool() {
+		assistantRequest.Tools = append(assistantRequest.Tools, assistantTool{Type: "code_interpreter"})
+	}
+
+	toolResources, diags := toolResourcesValue(ctx, m.ToolResources, clear)
+	assistantRequest.ToolResources = toolResources
+
+	metadata, d := stringMapValue(ctx, m.Metadata)
+	diags.Append(d...)
+	assistantRequest.Metadata = metadata
+
+	return assistantRequest, diags
+}
+
+// refresh populates the model from the assistant returned by the API. The
+// attributes OpenAI sets to a default when they are not configured are kept
+// as configured.
+func (m *assistantResourceModel) refresh(ctx context.Context, assistant assistantObject) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.ID = types.StringValue(assistant.ID)
+	m.Name = types.StringValue(assistant.Name)
+	m.Instructions = types.StringValue(assistant.Instructions)
+	m.CreatedAt = types.Int64Value(assistant.CreatedAt)
+
+	// OpenAI may resolve a model alias to its latest snapshot, e.g. gpt-4o to
+	// gpt-4o-2024-08-06, the alias is kept.
+	snapshot, ok := strings.CutPrefix(assistant.Model, m.Model.ValueString())
+	if !ok || !modelSnapshotPattern.MatchString(snapshot) {
+		m.Model = types.StringValue(assistant.Model)
+	}
+
+	// The API reports the default sampling parameters, they are only kept
+	// when they were configured.
+	if !m.Temperature.IsNull() && assistant.Temperature != nil {
+		m.Temperature = types.Float64Value(*assistant.Temperature)
+	}
+	if !m.TopP.IsNull() && assistant.TopP != nil {
+		m.TopP = types.Float64Value(*assistant.TopP)
+	}
+
+	m.EnableFileSearch = types.BoolValue(slices.Contains(assistant.Tools, assistantTool{Type: "file_search"}))
+	m.EnableCodeInterpreter = types.BoolValue(slices.Contains(assistant.Tools, assistantTool{Type: "code_interpreter"}))
+
+	// A cleared description is reported as empty.
+	if assistant.Description != nil && (*assistant.Description != "" || !m.Description.IsNull()) {
+		m.Description = types.StringValue(*assistant.Description)
+	}
+	if assistant.Description == nil {
+		m.Description = types.StringNull()
+	}
+
+	m.ToolResources, d = toolResourcesFromAPI(ctx, m.ToolResources, assistant.ToolResources)
+	diags.Append(d...)
+
+	m.Metadata, d = stringMapFromAPI(ctx, m.Metadata, assistant.userMetadata())
+	diags.Append(d...)
+
+	return diags
+}
+
+// userMetadata returns the metadata of the assistant without the create
+// marker, which is not part of the configuration.
+func (a assistantObject) userMetadata() map[string]string {
+	metadata := map[string]string{}
+	for key, value := range a.Metadata {
+		if key != createMarkerKey {
+			metadata[key] = value
+		}
+	}
+
+	return metadata
+}
+
+// findAssistant looks up the assistant created with the marker among the
+// latest assistants.
+func (c *openaiClient) findAssistant(ctx context.Context, marker string) (assistantObject, bool, error) {
+	query := url.Values{"limit": {"100"}, "order": {"desc"}}
+
+	var assistants struct {
+		Data []assistantObject `json:"data"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/assistants?"+query.Encode(), nil, &assistants); err != nil {
+		return assistantObject{}, false, err
+	}
+
+	for _, assistant := range assistants.Data {
+		if assistant.Metadata[createMarkerKey] == marker {
+			return assistant, true, nil
+		}
+	}
+
+	return assistantObject{}, false, nil
 }
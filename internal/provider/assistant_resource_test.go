@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAssistantResourceModelRequest(t *testing.T) {
+	ctx := context.Background()
+	model := assistantResourceModel{
+		Name:                  types.StringValue(testAccNamePrefix + "assistant"),
+		Description:           types.StringNull(),
+		Model:                 types.StringValue("gpt-4o"),
+		Instructions:          types.StringValue("You answer the tests of the provider."),
+		Temperature:           types.Float64Null(),
+		TopP:                  types.Float64Value(0.5),
+		EnableCodeInterpreter: types.BoolValue(true),
+		Metadata:              types.MapNull(types.StringType),
+	}
+
+	// The attributes not configured are left to the defaults of OpenAI on
+	// create.
+	request, diags := model.request(ctx, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if request.Description != nil || request.Temperature != nil || request.ToolResources != nil {
+		t.Errorf("expected the attributes not configured not to be sent, got %+v", request)
+	}
+	if request.TopP == nil || *request.TopP != 0.5 {
+		t.Errorf("expected top_p 0.5, got %v", request.TopP)
+	}
+	if len(request.Tools) != 1 || request.Tools[0].Type != "code_interpreter" {
+		t.Errorf("expected the code_interpreter tool, got %v", request.Tools)
+	}
+
+	// The attributes removed from the configuration are cleared on update.
+	request, diags = model.request(ctx, true)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if request.Description == nil || *request.Description != "" {
+		t.Errorf("expected the description to be cleared, got %v", request.Description)
+	}
+	if request.Temperature == nil || *request.Temperature != defaultAssistantSampling {
+		t.Errorf("expected the temperature to be reset, got %v", request.Temperature)
+	}
+	if request.ToolResources == nil {
+		t.Error("expected the tool resources to be cleared")
+	}
+}
+
+func TestAssistantResourceModelRefresh(t *testing.T) {
+	server := newMockOpenAI(t)
+	client := server.client()
+	ctx := context.Background()
+
+	name := testAccNamePrefix + "assistant"
+	description := ""
+	var assistant assistantObject
+	err := client.doJSON(ctx, http.MethodPost, "/assistants", assistantRequest{
+		Name:        &name,
+		Description: &description,
+		Model:       "gpt-4o-2024-08-06",
+		Metadata:    map[string]string{createMarkerKey: "marker"},
+	}, &assistant)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	model := assistantResourceModel{
+		Description: types.StringNull(),
+		Model:       types.StringValue("gpt-4o"),
+		Temperature: types.Float64Null(),
+		TopP:        types.Float64Value(0.5),
+		Metadata:    types.MapNull(types.StringType),
+	}
+	if diags := model.refresh(ctx, assistant); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if model.ID.ValueString() != assistant.ID || model.Name.ValueString() != name {
+		t.Errorf("expected the assistant %s named %s, got %s named %s", assistant.ID, name, model.ID, model.Name)
+	}
+	// The alias of the snapshot is kept.
+	if model.Model.ValueString() != "gpt-4o" {
+		t.Errorf("expected the model alias to be kept, got %s", model.Model)
+	}
+	// The cleared description and the default temperature are not configured.
+	if !model.Description.IsNull() || !model.Temperature.IsNull() {
+		t.Errorf("expected a null description and temperature, got %s and %s", model.Description, model.Temperature)
+	}
+	// The configured top_p is refreshed from OpenAI.
+	if model.TopP.ValueFloat64() != defaultAssistantSampling {
+		t.Errorf("expected top_p %v, got %s", defaultAssistantSampling, model.TopP)
+	}
+	// The create marker is not part of the configuration.
+	if !model.Metadata.IsNull() {
+		t.Errorf("expected null metadata, got %s", model.Metadata)
+	}
+}
+
+func TestAccAssistantResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed("openai_assistant", "/assistants/"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssistantResourceConfig("You answer the tests of the provider."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openai_assistant.test", "id"),
+					resource.TestCheckResourceAttr("openai_assistant.test", "name", testAccNamePrefix+"assistant"),
+					resource.TestCheckResourceAttr("openai_assistant.test", "instructions", "You answer the tests of the provider."),
+					resource.TestCheckResourceAttr("openai_assistant.test", "deletion_protection", "false"),
+				),
+			},
+			{
+				ResourceName:            "openai_assistant.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+			{
+				Config: testAccAssistantResourceConfig("You answer the updated tests of the provider."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_assistant.test", "instructions", "You answer the updated tests of the provider."),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssistantResourceConfig(instructions string) string {
+	return fmt.Sprintf(`
+resource "openai_assistant" "test" {
+  name         = %q
+  model        = "gpt-4o"
+  instructions = %q
+}
+`, testAccNamePrefix+"assistant", instructions)
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringListValue converts a list attribute to the strings sent to the API. A
+// null list is converted to no strings.
+func stringListValue(ctx context.Context, value types.List) ([]string, diag.Diagnostics) {
+	var result []string
+	if value.IsNull() || value.IsUnknown() {
+		return result, nil
+	}
+
+	diags := value.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// stringListFromAPI converts strings returned by the API in no particular
+// order to a list attribute. The order of the current list is kept when it
+// holds the same strings, and an empty list is kept null when the attribute
+// was null, so neither shows a diff.
+func stringListFromAPI(ctx context.Context, current types.List, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 && current.IsNull() {
+		return current, nil
+	}
+
+	currentValues, diags := stringListValue(ctx, current)
+	if diags.HasError() {
+		return current, diags
+	}
+	if added, removed := diffStrings(currentValues, values); len(added) == 0 && len(removed) == 0 {
+		return current, nil
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+// diffStrings returns the strings of target missing from current, and the
+// strings of current missing from target.
+func diffStrings(current, target []string) (added, removed []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, value := range current {
+		currentSet[value] = true
+	}
+	targetSet := make(map[string]bool, len(target))
+	for _, value := range target {
+		targetSet[value] = true
+		if !currentSet[value] {
+			added = append(added, value)
+		}
+	}
+	for _, value := range current {
+		if !targetSet[value] {
+			removed = append(removed, value)
+		}
+	}
+
+	return added, removed
+}
+
+// stringMapValue converts a map attribute to the map sent to the API. A null
+// map is sent as an empty map, which clears the values on update.
+func stringMapValue(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	result := map[string]string{}
+	if value.IsNull() || value.IsUnknown() {
+		return result, nil
+	}
+
+	diags := value.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// stringMapFromAPI converts a map returned by the API to a map attribute. An
+// empty map is kept null when the attribute was null, so an unset attribute
+// does not show a diff.
+func stringMapFromAPI(ctx context.Context, current types.Map, values map[string]string) (types.Map, diag.Diagnostics) {
+	if len(values) == 0 && current.IsNull() {
+		return current, nil
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
+// metadataMaxPairs, metadataMaxKeyLength and metadataMaxValueLength are the
+// limits of the metadata of the OpenAI objects.
+const (
+	metadataMaxPairs       = 16
+	metadataMaxKeyLength   = 64
+	metadataMaxValueLength = 512
+)
+
+// metadataValidators returns the validators of a metadata attribute: OpenAI
+// accepts up to 16 pairs, with keys of up to 64 characters and values of up to
+// 512 characters.
+func metadataValidators() []validator.Map {
+	return []validator.Map{
+		mapvalidator.SizeAtMost(metadataMaxPairs),
+		mapvalidator.KeysAre(stringvalidator.LengthAtMost(metadataMaxKeyLength)),
+		mapvalidator.ValueStringsAre(stringvalidator.LengthAtMost(metadataMaxValueLength)),
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffStrings(t *testing.T) {
+	tests := map[string]struct {
+		current, target []string
+		added, removed  []string
+	}{
+		"unchanged": {
+			current: []string{"file-abc", "file-def"},
+			target:  []string{"file-def", "file-abc"},
+		},
+		"added": {
+			current: []string{"file-abc"},
+			target:  []string{"file-abc", "file-def"},
+			added:   []string{"file-def"},
+		},
+		"removed": {
+			current: []string{"file-abc", "file-def"},
+			target:  []string{"file-def"},
+			removed: []string{"file-abc"},
+		},
+		"replaced": {
+			current: []string{"file-abc"},
+			target:  []string{"file-def"},
+			added:   []string{"file-def"},
+			removed: []string{"file-abc"},
+		},
+		"from empty": {
+			target: []string{"file-abc", "file-def"},
+			added:  []string{"file-abc", "file-def"},
+		},
+		"to empty": {
+			current: []string{"file-abc", "file-def"},
+			removed: []string{"file-abc", "file-def"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			added, removed := diffStrings(test.current, test.target)
+			if !reflect.DeepEqual(added, test.added) || !reflect.DeepEqual(removed, test.removed) {
+				t.Errorf("expected added %v and removed %v, got %v and %v", test.added, test.removed, added, removed)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &audioTranscriptionDataSource{}
+	_ datasource.DataSourceWithConfigure      = &audioTranscriptionDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &audioTranscriptionDataSource{}
+)
+
+// audioResponseFormats are the response formats supported by the
+// transcription and translation endpoints.
+var audioResponseFormats = []string{"json", "text", "srt", "verbose_json", "vtt"}
+
+// audioTextResponse is the body of a transcription or translation response in
+// the json or verbose_json format.
+type audioTextResponse struct {
+	Text     string  `json:"text"`
+	Language string  `json:"language"`
+	Duration float64 `json:"duration"`
+	Segments []struct {
+		ID    int64   `json:"id"`
+		Start float64 `json:"start"`
+		End   float64 `json:"end"`
+		Text  string  `json:"text"`
+	} `json:"segments"`
+	Words []struct {
+		Word  string  `json:"word"`
+		Start float64 `json:"start"`
+		End   float64 `json:"end"`
+	} `json:"words"`
+}
+
+// audioSegmentModel maps a timestamped segment of a transcript.
+type audioSegmentModel struct {
+	ID    types.Int64   `tfsdk:"id"`
+	Start types.Float64 `tfsdk:"start"`
+	End   types.Float64 `tfsdk:"end"`
+	Text  types.String  `tfsdk:"text"`
+}
+
+// audioWordModel maps a timestamped word of a transcript.
+type audioWordModel struct {
+	Word  types.String  `tfsdk:"word"`
+	Start types.Float64 `tfsdk:"start"`
+	End   types.Float64 `tfsdk:"end"`
+}
+
+// NewAudioTranscriptionDataSource is a helper function to simplify the provider implementation.
+func NewAudioTranscriptionDataSource() datasource.DataSource {
+	return &audioTranscriptionDataSource{}
+}
+
+// audioTranscriptionDataSource is the data source implementation.
+type audioTranscriptionDataSource struct {
+	client *openaiClient
+}
+
+// audioTranscriptionDataSourceModel maps the data source schema data.
+type audioTranscriptionDataSourceModel struct {
+	FilePath               types.String        `tfsdk:"file_path"`
+	Model                  types.String        `tfsdk:"model"`
+	Language               types.String        `tfsdk:"language"`
+	Prompt                 types.String        `tfsdk:"prompt"`
+	Temperature            types.Float64       `tfsdk:"temperature"`
+	ResponseFormat         types.String        `tfsdk:"response_format"`
+	TimestampGranularities types.List          `tfsdk:"timestamp_granularities"`
+	Text                   types.String        `tfsdk:"text"`
+	DetectedLanguage       types.String        `tfsdk:"detected_language"`
+	Duration               types.Float64       `tfsdk:"duration"`
+	Segments               []audioSegmentModel `tfsdk:"segments"`
+	Words                  []audioWordModel    `tfsdk:"words"`
+}
+
+// Metadata returns the data source type name.
+func (d *audioTranscriptionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audio_transcription"
+}
+
+// Schema defines the schema for the data source.
+func (d *audioTranscriptionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Transcribes a local audio file into text.",
+		Attributes: map[string]schema.Attribute{
+			"file_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the audio file to transcribe, in one of the `flac`, `mp3`, `mp4`, `mpeg`, `mpga`, `m4a`, `ogg`, `wav` or `webm` formats.",
+				Required:            true,
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Transcription model, such as `whisper-1`, `gpt-4o-transcribe` or `gpt-4o-mini-transcribe`.",
+				Required:            true,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "Language of the audio, in the ISO-639-1 format such as `en`. Improves the accuracy and latency of the transcription.",
+				Optional:            true,
+			},
+			"prompt": schema.StringAttribute{
+				Description: "Text to guide the style of the transcript or continue a previous audio segment, in the language of the audio.",
+				Optional:    true,
+			},
+			"temperature": schema.Float64Attribute{
+				Description: "Sampling temperature, between 0 and 1.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"response_format": schema.StringAttribute{
+				MarkdownDescription: "Format of the transcript, either `json`, `text`, `srt`, `verbose_json` or `vtt`. Defaults to `json`. `srt` and `vtt` return captions with timestamps in `text`, `verbose_json` fills `detected_language`, `duration`, `segments` and `words`. GPT models only support `json` and `text`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(audioResponseFormats...),
+				},
+			},
+			"timestamp_granularities": schema.ListAttribute{
+				MarkdownDescription: "Granularities of the timestamps, `segment` and/or `word`. Requires the `verbose_json` response format.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("segment", "word")),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "Transcript of the audio, in the requested `response_format` for `text`, `srt` and `vtt`.",
+				Computed:            true,
+			},
+			"detected_language": schema.StringAttribute{
+				MarkdownDescription: "Language detected in the audio. Only set with the `verbose_json` response format.",
+				Computed:            true,
+			},
+			"duration": schema.Float64Attribute{
+				MarkdownDescription: "Duration of the audio, in seconds. Only set with the `verbose_json` response format.",
+				Computed:            true,
+			},
+			"segments": schema.ListNestedAttribute{
+				MarkdownDescription: "Timestamped segments of the transcript. Only set with the `verbose_json` response format.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Index of the segment.",
+							Computed:    true,
+						},
+						"start": schema.Float64Attribute{
+							Description: "Start time of the segment, in seconds.",
+							Computed:    true,
+						},
+						"end": schema.Float64Attribute{
+							Description: "End time of the segment, in seconds.",
+							Computed:    true,
+						},
+						"text": schema.StringAttribute{
+							Description: "Text of the segment.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"words": schema.ListNestedAttribute{
+				MarkdownDescription: "Timestamped words of the transcript. Only set with the `verbose_json` response format and the `word` timestamp granularity.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"word": schema.StringAttribute{
+							Description: "Text of the word.",
+							Computed:    true,
+						},
+						"start": schema.Float64Attribute{
+							Description: "Start time of the word, in seconds.",
+							Computed:    true,
+						},
+						"end": schema.Float64Attribute{
+							Description: "End time of the word, in seconds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *audioTranscriptionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig validates the data source configuration.
+func (d *audioTranscriptionDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config audioTranscriptionDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TimestampGranularities.IsNull() && !config.ResponseFormat.IsUnknown() && config.ResponseFormat.ValueString() != "verbose_json" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timestamp_granularities"),
+			"Unsupported timestamp granularities",
+			"timestamp_granularities requires the verbose_json response format.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *audioTranscriptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data audioTranscriptionDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addModelDeprecationWarning(&resp.Diagnostics, path.Root("model"), data.Model.ValueString(), time.Now())
+
+	fields := url.Values{
+		"model":           {data.Model.ValueString()},
+		"language":        {data.Language.ValueString()},
+		"prompt":          {data.Prompt.ValueString()},
+		"response_format": {data.ResponseFormat.ValueString()},
+	}
+	if !data.Temperature.IsNull() {
+		fields.Set("temperature", strconv.FormatFloat(data.Temperature.ValueFloat64(), 'f', -1, 64))
+	}
+	if !data.TimestampGranularities.IsNull() {
+		var granularities []string
+		diags = data.TimestampGranularities.ElementsAs(ctx, &granularities, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		fields["timestamp_granularities[]"] = granularities
+	}
+
+	transcript, err := d.client.createAudioText(ctx, "/audio/transcriptions", data.FilePath.ValueString(), data.ResponseFormat.ValueString(), fields)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Unable to transcribe audio", "", err)
+		return
+	}
+
+	data.Text = types.StringValue(transcript.Text)
+	data.DetectedLanguage = types.StringNull()
+	data.Duration = types.Float64Null()
+	if data.ResponseFormat.ValueString() == "verbose_json" {
+		data.DetectedLanguage = types.StringValue(transcript.Language)
+		data.Duration = types.Float64Value(transcript.Duration)
+	}
+	data.Segments, data.Words = transcript.timestamps()
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// createAudioText uploads the audio file to the transcription or translation
+// endpoint. Responses in the text, srt and vtt formats are returned as is in
+// the Text field.
+func (c *openaiClient) createAudioText(ctx context.Context, endpoint, filePath, responseFormat string, fields url.Values) (audioTextResponse, error) {
+	var result audioTextResponse
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return result, err
+	}
+
+	var body []byte
+	err = c.doMultipart(ctx, endpoint, fields, []multipartFile{
+		{field: "file", name: filepath.Base(filePath), content: content},
+	}, &body)
+	if err != nil {
+		return result, err
+	}
+
+	if responseFormat != "" && responseFormat != "json" && responseFormat != "verbose_json" {
+		result.Text = string(body)
+		return result, nil
+	}
+
+	err = json.Unmarshal(body, &result)
+	return result, err
+}
+
+// timestamps maps the segments and words of the response.
+func (r audioTextResponse) timestamps() ([]audioSegmentModel, []audioWordModel) {
+	segments := []audioSegmentModel{}
+	for _, segment := range r.Segments {
+		segments = append(segments, audioSegmentModel{
+			ID:    types.Int64Value(segment.ID),
+			Start: types.Float64Value(segment.Start),
+			End:   types.Float64Value(segment.End),
+			Text:  types.StringValue(segment.Text),
+		})
+	}
+
+	words := []audioWordModel{}
+	for _, word := range r.Words {
+		words = append(words, audioWordModel{
+			Word:  types.StringValue(word.Word),
+			Start: types.Float64Value(word.Start),
+			End:   types.Float64Value(word.End),
+		})
+	}
+
+	return segments, words
+}
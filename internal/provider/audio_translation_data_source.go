@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &audioTranslationDataSource{}
+	_ datasource.DataSourceWithConfigure = &audioTranslationDataSource{}
+)
+
+// NewAudioTranslationDataSource is a helper function to simplify the provider implementation.
+func NewAudioTranslationDataSource() datasource.DataSource {
+	return &audioTranslationDataSource{}
+}
+
+// audioTranslationDataSource is the data source implementation.
+type audioTranslationDataSource struct {
+	client *openaiClient
+}
+
+// audioTranslationDataSourceModel maps the data source schema data.
+type audioTranslationDataSourceModel struct {
+	FilePath       types.String        `tfsdk:"file_path"`
+	Model          types.String        `tfsdk:"model"`
+	Prompt         types.String        `tfsdk:"prompt"`
+	Temperature    types.Float64       `tfsdk:"temperature"`
+	ResponseFormat types.String        `tfsdk:"response_format"`
+	Text           types.String        `tfsdk:"text"`
+	Duration       types.Float64       `tfsdk:"duration"`
+	Segments       []audioSegmentModel `tfsdk:"segments"`
+}
+
+// Metadata returns the data source type name.
+func (d *audioTranslationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audio_translation"
+}
+
+// Schema defines the schema for the data source.
+func (d *audioTranslationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Translates a local audio file into English text.",
+		Attributes: map[string]schema.Attribute{
+			"file_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the audio file to translate, in one of the `flac`, `mp3`, `mp4`, `mpeg`, `mpga`, `m4a`, `ogg`, `wav` or `webm` formats.",
+				Required:            true,
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Translation model. Only `whisper-1` is supported by OpenAI at this time.",
+				Required:            true,
+			},
+			"prompt": schema.StringAttribute{
+				Description: "Text to guide the style of the translation or continue a previous audio segment, in English.",
+				Optional:    true,
+			},
+			"temperature": schema.Float64Attribute{
+				Description: "Sampling temperature, between 0 and 1.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"response_format": schema.StringAttribute{
+				MarkdownDescription: "Format of the translation, either `json`, `text`, `srt`, `verbose_json` or `vtt`. Defaults to `json`. `srt` and `vtt` return captions with timestamps in `text`, `verbose_json` fills `duration` and `segments`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(audioResponseFormats...),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "English translation of the audio, in the requested `response_format` for `text`, `srt` and `vtt`.",
+				Computed:            true,
+			},
+			"duration": schema.Float64Attribute{
+				MarkdownDescription: "Duration of the audio, in seconds. Only set with the `verbose_json` response format.",
+				Computed:            true,
+			},
+			"segments": schema.ListNestedAttribute{
+				MarkdownDescription: "Timestamped segments of the translation. Only set with the `verbose_json` response format.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Index of the segment.",
+							Computed:    true,
+						},
+						"start": schema.Float64Attribute{
+							Description: "Start time of the segment, in seconds.",
+							Computed:    true,
+						},
+						"end": schema.Float64Attribute{
+							Description: "End time of the segment, in seconds.",
+							Computed:    true,
+						},
+						"text": schema.StringAttribute{
+							Description: "Text of the segment.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *audioTranslationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *audioTranslationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data audioTranslationDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addModelDeprecationWarning(&resp.Diagnostics, path.Root("model"), data.Model.ValueString(), time.Now())
+
+	fields := url.Values{
+		"model":           {data.Model.ValueString()},
+		"prompt":          {data.Prompt.ValueString()},
+		"response_format": {data.ResponseFormat.ValueString()},
+	}
+	if !data.Temperature.IsNull() {
+		fields.Set("temperature", strconv.FormatFloat(data.Temperature.ValueFloat64(), 'f', -1, 64))
+	}
+
+	translation, err := d.client.createAudioText(ctx, "/audio/translations", data.FilePath.ValueString(), data.ResponseFormat.ValueString(), fields)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Unable to translate audio", "", err)
+		return
+	}
+
+	data.Text = types.StringValue(translation.Text)
+	data.Duration = types.Float64Null()
+	if data.ResponseFormat.ValueString() == "verbose_json" {
+		data.Duration = types.Float64Value(translation.Duration)
+	}
+	data.Segments, _ = translation.timestamps()
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &batchOutputDataSource{}
+	_ datasource.DataSourceWithConfigure = &batchOutputDataSource{}
+)
+
+// NewBatchOutputDataSource is a helper function to simplify the provider implementation.
+func NewBatchOutputDataSource() datasource.DataSource {
+	return &batchOutputDataSource{}
+}
+
+// batchOutputDataSource is the data source implementation.
+type batchOutputDataSource struct {
+	client *openaiClient
+}
+
+// batchOutputDataSourceModel maps the data source schema data.
+type batchOutputDataSourceModel struct {
+	FileID         types.String                      `tfsdk:"file_id"`
+	Results        map[string]batchOutputResultModel `tfsdk:"results"`
+	SucceededCount types.Int64                       `tfsdk:"succeeded_count"`
+	FailedCount    types.Int64                       `tfsdk:"failed_count"`
+}
+
+// batchOutputResultModel maps the result of a single request of the batch.
+type batchOutputResultModel struct {
+	RequestID    types.String `tfsdk:"request_id"`
+	StatusCode   types.Int64  `tfsdk:"status_code"`
+	Body         types.String `tfsdk:"body"`
+	Content      types.String `tfsdk:"content"`
+	ErrorCode    types.String `tfsdk:"error_code"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+}
+
+// batchOutputLine is a line of a batch output or error file.
+type batchOutputLine struct {
+	CustomID string `json:"custom_id"`
+	Response *struct {
+		StatusCode int             `json:"status_code"`
+		RequestID  string          `json:"request_id"`
+		Body       json.RawMessage `json:"body"`
+	} `json:"response"`
+	Error *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// batchOutputBody is the part of the response bodies of the chat completions
+// and Responses APIs holding the generated text.
+type batchOutputBody struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+	} `json:"choices"`
+	Output []struct {
+		Content []struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"content"`
+	} `json:"output"`
+	Error *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Metadata returns the data source type name.
+func (d *batchOutputDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_batch_output"
+}
+
+// Schema defines the schema for the data source.
+func (d *batchOutputDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Downloads and parses the JSONL output, or error, file of a batch, exposing the result of each request keyed by its `custom_id`.",
+		Attributes: map[string]schema.Attribute{
+			"file_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the output file, or error file, of the batch.",
+				Required:            true,
+			},
+			"results": schema.MapNestedAttribute{
+				MarkdownDescription: "Results of the requests of the batch, keyed by `custom_id`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"request_id": schema.StringAttribute{
+							Description: "ID of the API request.",
+							Computed:    true,
+						},
+						"status_code": schema.Int64Attribute{
+							Description: "HTTP status code of the response.",
+							Computed:    true,
+						},
+						"body": schema.StringAttribute{
+							MarkdownDescription: "JSON body of the response, to decode with `jsondecode`.",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							Description: "Text generated by the model, for chat completions and responses.",
+							Computed:    true,
+						},
+						"error_code": schema.StringAttribute{
+							Description: "Code of the error of the request, when it failed.",
+							Computed:    true,
+						},
+						"error_message": schema.StringAttribute{
+							Description: "Message of the error of the request, when it failed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"succeeded_count": schema.Int64Attribute{
+				Description: "Number of requests completed with a successful HTTP status.",
+				Computed:    true,
+			},
+			"failed_count": schema.Int64Attribute{
+				Description: "Number of requests that failed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *batchOutputDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *batchOutputDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data batchOutputDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var content []byte
+	err := d.client.doJSON(ctx, http.MethodGet, "/files/"+data.FileID.ValueString()+"/content", nil, &content)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to download batch output file", "", err)
+		return
+	}
+
+	lines, err := readBatchOutputLines(content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse batch output file",
+			"Could not parse file "+data.FileID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	data.Results = map[string]batchOutputResultModel{}
+	succeeded, failed := 0, 0
+	for _, line := range lines {
+		result := line.result()
+		if result.StatusCode.ValueInt64() >= http.StatusOK && result.StatusCode.ValueInt64() < http.StatusMultipleChoices {
+			succeeded++
+		} else {
+			failed++
+		}
+		data.Results[line.CustomID] = result
+	}
+	data.SucceededCount = types.Int64Value(int64(succeeded))
+	data.FailedCount = types.Int64Value(int64(failed))
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// readBatchOutputLines parses the lines of a batch output or error file.
+func readBatchOutputLines(content []byte) ([]batchOutputLine, error) {
+	var lines []batchOutputLine
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(content)+1)
+
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		var value batchOutputLine
+		if err := json.Unmarshal(scanner.Bytes(), &value); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if value.CustomID == "" {
+			return nil, fmt.Errorf("line %d: custom_id is missing", line)
+		}
+		lines = append(lines, value)
+	}
+
+	return lines, scanner.Err()
+}
+
+// result maps the line to the result of its request. The error is taken from
+// the line, or else from the body of an unsuccessful response.
+func (l batchOutputLine) result() batchOutputResultModel {
+	result := batchOutputResultModel{
+		RequestID:    types.StringNull(),
+		StatusCode:   types.Int64Null(),
+		Body:         types.StringNull(),
+		Content:      types.StringNull(),
+		ErrorCode:    types.StringNull(),
+		ErrorMessage: types.StringNull(),
+	}
+
+	if l.Error != nil {
+		result.ErrorCode = types.StringValue(l.Error.Code)
+		result.ErrorMessage = types.StringValue(l.Error.Message)
+	}
+
+	if l.Response == nil {
+		return result
+	}
+
+	result.RequestID = types.StringValue(l.Response.RequestID)
+	result.StatusCode = types.Int64Value(int64(l.Response.StatusCode))
+	if len(l.Response.Body) == 0 {
+		return result
+	}
+	result.Body = types.StringValue(string(l.Response.Body))
+
+	var body batchOutputBody
+	if err := json.Unmarshal(l.Response.Body, &body); err != nil {
+		return result
+	}
+
+	if body.Error != nil && l.Error == nil {
+		result.ErrorCode = types.StringValue(body.Error.Code)
+		result.ErrorMessage = types.StringValue(body.Error.Message)
+	}
+
+	if len(body.Choices) > 0 {
+		result.Content = types.StringValue(body.Choices[0].Message.Content)
+	}
+
+	var text strings.Builder
+	for _, output := range body.Output {
+		for _, content := range output.Content {
+			if content.Type == "output_text" {
+				text.WriteString(content.Text)
+			}
+		}
+	}
+	if text.Len() > 0 {
+		result.Content = types.StringValue(text.String())
+	}
+
+	return result
+}
@@ -0,0 +1,589 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &batchResource{}
+	_ resource.ResourceWithConfigure      = &batchResource{}
+	_ resource.ResourceWithModifyPlan     = &batchResource{}
+	_ resource.ResourceWithValidateConfig = &batchResource{}
+)
+
+// batchPollInterval is the interval between two checks of the status of a
+// batch. Batches take minutes to hours, there is no point in polling faster.
+const batchPollInterval = 30 * time.Second
+
+// batchTimeout is the default create timeout of a batch, long enough for the
+// batches waited for to complete within their 24 hours completion window and
+// to be finalized.
+const batchTimeout = 25 * time.Hour
+
+// batchEndpoints are the endpoints the requests of a batch can be sent to.
+var batchEndpoints = []string{"/v1/responses", "/v1/chat/completions", "/v1/embeddings", "/v1/completions", "/v1/moderations"}
+
+// NewBatchResource is a helper function to simplify the provider implementation.
+func NewBatchResource() resource.Resource {
+	return &batchResource{}
+}
+
+// batchResource is the resource implementation.
+type batchResource struct {
+	client *openaiClient
+}
+
+// batchResourceModel maps the resource schema data.
+type batchResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	SourcePath        types.String   `tfsdk:"source_path"`
+	Content           types.String   `tfsdk:"content"`
+	Endpoint          types.String   `tfsdk:"endpoint"`
+	CompletionWindow  types.String   `tfsdk:"completion_window"`
+	Metadata          types.Map      `tfsdk:"metadata"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	SourceSHA256      types.String   `tfsdk:"source_sha256"`
+	InputFileID       types.String   `tfsdk:"input_file_id"`
+	Status            types.String   `tfsdk:"status"`
+	OutputFileID      types.String   `tfsdk:"output_file_id"`
+	ErrorFileID       types.String   `tfsdk:"error_file_id"`
+	RequestCounts     types.Object   `tfsdk:"request_counts"`
+	CreatedAt         types.Int64    `tfsdk:"created_at"`
+	Project           types.String   `tfsdk:"project"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// batchRequestCountsType is the type of the request_counts attribute.
+var batchRequestCountsType = map[string]attr.Type{
+	"total":     types.Int64Type,
+	"completed": types.Int64Type,
+	"failed":    types.Int64Type,
+}
+
+// batchRequest is the body of a request creating a batch.
+type batchRequest struct {
+	InputFileID      string            `json:"input_file_id"`
+	Endpoint         string            `json:"endpoint"`
+	CompletionWindow string            `json:"completion_window"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+}
+
+// batchObject is a batch returned by the Batch API.
+type batchObject struct {
+	ID            string `json:"id"`
+	Status        string `json:"status"`
+	InputFileID   string `json:"input_file_id"`
+	OutputFileID  string `json:"output_file_id"`
+	ErrorFileID   string `json:"error_file_id"`
+	CreatedAt     int64  `json:"created_at"`
+	RequestCounts struct {
+		Total     int64 `json:"total"`
+		Completed int64 `json:"completed"`
+		Failed    int64 `json:"failed"`
+	} `json:"request_counts"`
+	Errors *struct {
+		Data []struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+			Line    *int64 `json:"line"`
+		} `json:"data"`
+	} `json:"errors"`
+}
+
+// Metadata returns the resource type name.
+func (r *batchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_batch"
+}
+
+// Schema defines the schema for the resource.
+func (r *batchResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a JSONL input file and runs its requests asynchronously with the Batch API. Read the results with the `openai_batch_output` data source. Destroying the batch cancels it when still running, and deletes its input file.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the batch.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the local JSONL input file, one request per line. Conflicts with `content`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the JSONL input file, one request per line, e.g. joined from `jsonencode` results. Conflicts with `source_path`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Endpoint the requests of the batch are sent to, either `/v1/responses`, `/v1/chat/completions`, `/v1/embeddings`, `/v1/completions` or `/v1/moderations`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(batchEndpoints...),
+				},
+			},
+			"completion_window": schema.StringAttribute{
+				MarkdownDescription: "Time frame within which the batch is processed. Only `24h` is supported, and is the default.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("24h"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("24h"),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the batch.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  metadataValidators(),
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the batch to complete before returning. When `false`, the status and the output files are refreshed on the next plans. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"source_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the content of the input file. A change of the checksum creates a new batch.",
+				Computed:    true,
+			},
+			"input_file_id": schema.StringAttribute{
+				Description: "ID of the uploaded input file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the batch, such as `validating`, `in_progress`, `finalizing`, `completed`, `failed`, `expired` or `cancelled`.",
+				Computed:            true,
+			},
+			"output_file_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the file holding the responses of the successful requests, to read with the `openai_batch_output` data source. Empty until the batch completes.",
+				Computed:            true,
+			},
+			"error_file_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the file holding the errors of the failed requests, to read with the `openai_batch_output` data source. Empty when no request failed.",
+				Computed:            true,
+			},
+			"request_counts": schema.SingleNestedAttribute{
+				Description: "Number of requests of the batch, per result.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"total": schema.Int64Attribute{
+						Description: "Number of requests.",
+						Computed:    true,
+					},
+					"completed": schema.Int64Attribute{
+						Description: "Number of requests completed successfully.",
+						Computed:    true,
+					},
+					"failed": schema.Int64Attribute{
+						Description: "Number of requests that failed.",
+						Computed:    true,
+					},
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the batch.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the batch, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *batchResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures exactly one source of the input file is set.
+func (r *batchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config batchResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.SourcePath.IsNull() && !config.Content.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Conflicting input file source",
+			"Only one of source_path or content can be set.",
+		)
+	}
+
+	if config.SourcePath.IsNull() && config.Content.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing input file source",
+			"One of source_path or content must be set.",
+		)
+	}
+}
+
+// ModifyPlan computes the checksum of the content of the input file, so a
+// change of the local file creates a new batch.
+func (r *batchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan batchResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.SourcePath.IsUnknown() || plan.Content.IsUnknown() {
+		return
+	}
+
+	if !plan.Content.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), sha256Hex([]byte(plan.Content.ValueString())))...)
+		return
+	}
+
+	modifyPlanLocalFilesChecksum(ctx, req, resp, path.Root("source_sha256"), plan.SourcePath.ValueString())
+}
+
+// Create a new resource.
+func (r *batchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan batchResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, batchTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	content := []byte(plan.Content.ValueString())
+	filename := "batch_input.jsonl"
+	if !plan.SourcePath.IsNull() {
+		var err error
+		content, err = os.ReadFile(plan.SourcePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_path"),
+				"Error reading file content",
+				"Could not create batch, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		filename = filepath.Base(plan.SourcePath.ValueString())
+	}
+
+	file, err := r.client.uploadFile(ctx, filename, "batch", contentSection(content))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating batch", "Could not upload the input file, unexpected error: ", err)
+		return
+	}
+
+	batchRequest := batchRequest{
+		InputFileID:      file.ID,
+		Endpoint:         plan.Endpoint.ValueString(),
+		CompletionWindow: plan.CompletionWindow.ValueString(),
+	}
+
+	batchRequest.Metadata, diags = stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var batch batchObject
+	err = r.client.doJSON(ctx, http.MethodPost, "/batches", batchRequest, &batch)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating batch", "Could not create batch, unexpected error: ", err)
+		// The input file is not used by any batch, do not leave it behind.
+		_ = r.client.doJSON(ctx, http.MethodDelete, "/files/"+file.ID, nil, nil)
+		return
+	}
+
+	if plan.WaitForCompletion.ValueBool() {
+		batch, err = r.client.waitBatch(ctx, batch)
+		if err != nil {
+			// Keep track of the batch in state, its error file can be
+			// inspected and it is cancelled with the resource.
+			addAPIError(&resp.Diagnostics, "Error running batch", "Could not complete batch "+batch.ID+": ", err)
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.SourceSHA256 = types.StringValue(sha256Hex(content))
+	diags = plan.refresh(batch)
+	resp.Diagnostics.Append(diags...)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *batchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state batchResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed batch value from OpenAI
+	var batch batchObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/batches/"+state.ID.ValueString(), nil, &batch)
+	if removeNotFound(ctx, resp, err, "OpenAI batch "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI batch", "Could not read OpenAI batch ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	diags = state.refresh(batch)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *batchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Batches cannot be updated, only wait_for_completion changes in place.
+	var plan, state batchResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Status = state.Status
+	plan.OutputFileID = state.OutputFileID
+	plan.ErrorFileID = state.ErrorFileID
+	plan.RequestCounts = state.RequestCounts
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *batchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state batchResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Batches cannot be deleted, a running batch is cancelled so it does not
+	// keep consuming the quota.
+	var batch batchObject
+	err := r.client.doJSON(withFreshReads(ctx), http.MethodGet, "/batches/"+state.ID.ValueString(), nil, &batch)
+	if err != nil && errorStatusCode(err) != http.StatusNotFound {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI batch", "Could not read batch, unexpected error: ", err)
+		return
+	}
+
+	if err == nil && !batch.terminal() && batch.Status != "cancelling" {
+		err = r.client.doJSON(ctx, http.MethodPost, "/batches/"+state.ID.ValueString()+"/cancel", nil, nil)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error Deleting OpenAI batch", "Could not cancel batch, unexpected error: ", err)
+			return
+		}
+	}
+
+	// Delete the input file uploaded with the batch, the output and error
+	// files are kept.
+	err = r.client.doJSON(ctx, http.MethodDelete, "/files/"+state.InputFileID.ValueString(), nil, nil)
+	if err != nil && errorStatusCode(err) != http.StatusNotFound {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI batch", "Could not delete the input file, unexpected error: ", err)
+		return
+	}
+}
+
+// waitBatch polls the batch until it reaches a terminal status.
+func (c *openaiClient) waitBatch(ctx context.Context, batch batchObject) (batchObject, error) {
+	ctx = withFreshReads(ctx)
+
+	for !batch.terminal() {
+		select {
+		case <-ctx.Done():
+			return batch, ctx.Err()
+		case <-time.After(batchPollInterval):
+		}
+
+		// The first polls can hit a replica not aware of the new batch yet.
+		err := retryNotFound(ctx, func(ctx context.Context) error {
+			return c.doJSON(ctx, http.MethodGet, "/batches/"+batch.ID, nil, &batch)
+		})
+		if err != nil {
+			return batch, err
+		}
+	}
+
+	switch batch.Status {
+	case "failed":
+		return batch, fmt.Errorf("batch failed: %s", batch.errorMessage())
+	case "expired":
+		return batch, fmt.Errorf("batch expired with %d of %d requests completed", batch.RequestCounts.Completed, batch.RequestCounts.Total)
+	case "cancelled":
+		return batch, fmt.Errorf("batch cancelled")
+	}
+
+	return batch, nil
+}
+
+// terminal reports whether the batch reached a status it cannot leave.
+func (b batchObject) terminal() bool {
+	switch b.Status {
+	case "completed", "failed", "expired", "cancelled":
+		return true
+	}
+
+	return false
+}
+
+// errorMessage returns the errors of the validation of a failed batch.
+func (b batchObject) errorMessage() string {
+	if b.Errors == nil || len(b.Errors.Data) == 0 {
+		return "no error reported"
+	}
+
+	var messages []string
+	for _, batchError := range b.Errors.Data {
+		message := batchError.Code + ": " + batchError.Message
+		if batchError.Line != nil {
+			message = fmt.Sprintf("line %d: %s", *batchError.Line, message)
+		}
+		messages = append(messages, message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// refresh populates the computed attributes from the batch.
+func (m *batchResourceModel) refresh(batch batchObject) diag.Diagnostics {
+	m.ID = types.StringValue(batch.ID)
+	m.InputFileID = types.StringValue(batch.InputFileID)
+	m.Status = types.StringValue(batch.Status)
+	m.OutputFileID = types.StringValue(batch.OutputFileID)
+	m.ErrorFileID = types.StringValue(batch.ErrorFileID)
+	m.CreatedAt = types.Int64Value(batch.CreatedAt)
+
+	var diags diag.Diagnostics
+	m.RequestCounts, diags = types.ObjectValue(batchRequestCountsType, map[string]attr.Value{
+		"total":     types.Int64Value(batch.RequestCounts.Total),
+		"completed": types.Int64Value(batch.RequestCounts.Completed),
+		"failed":    types.Int64Value(batch.RequestCounts.Failed),
+	})
+
+	return diags
+}
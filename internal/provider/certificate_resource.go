@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &certificateResource{}
+	_ resource.ResourceWithConfigure = &certificateResource{}
+)
+
+// NewCertificateResource is a helper function to simplify the provider implementation.
+func NewCertificateResource() resource.Resource {
+	return &certificateResource{}
+}
+
+// certificateResource is the resource implementation.
+type certificateResource struct {
+	client *openaiClient
+}
+
+// certificateResourceModel maps the resource schema data.
+type certificateResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	Content   types.String   `tfsdk:"content"`
+	ProjectID types.String   `tfsdk:"project_id"`
+	Active    types.Bool     `tfsdk:"active"`
+	ValidAt   types.Int64    `tfsdk:"valid_at"`
+	ExpiresAt types.Int64    `tfsdk:"expires_at"`
+	CreatedAt types.Int64    `tfsdk:"created_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// certificateRequest is the body of a request uploading or renaming a
+// certificate.
+type certificateRequest struct {
+	Name    string `json:"name,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+// certificateObject is a certificate returned by the Certificates API. Only
+// the listings report whether the certificate is active.
+type certificateObject struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	CreatedAt          int64  `json:"created_at"`
+	Active             bool   `json:"active"`
+	CertificateDetails struct {
+		ValidAt   int64 `json:"valid_at"`
+		ExpiresAt int64 `json:"expires_at"`
+	} `json:"certificate_details"`
+}
+
+// Metadata returns the resource type name.
+func (r *certificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+// Schema defines the schema for the resource.
+func (r *certificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a certificate to the OpenAI organization, for the mutual TLS authentication of the requests, and activates it for the organization or a project. Requires the `admin_api_key` of the provider. Rotate a certificate by creating the new one before destroying the previous one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the certificate.",
+				Optional:    true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded content of the certificate, e.g. read with `file`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "ID of the OpenAI project the certificate is activated for. The certificate is activated for the whole organization when unset.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the certificate is active for the organization, or the project of `project_id`. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"valid_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the start of the validity of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the expiration of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the upload of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *certificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	r.client.requireAdminAPIKey(&resp.Diagnostics, "openai_certificate")
+}
+
+// Create a new resource.
+func (r *certificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan certificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	var certificate certificateObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/organization/certificates", certificateRequest{
+		Name:    plan.Name.ValueString(),
+		Content: plan.Content.ValueString(),
+	}, &certificate)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating certificate", "Could not upload certificate, unexpected error: ", err)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.refresh(certificate)
+
+	if plan.Active.ValueBool() {
+		err = r.client.setCertificateActive(ctx, plan.ProjectID.ValueString(), certificate.ID, true)
+		if err != nil {
+			// Keep track of the certificate in state, the activation is
+			// retried on the next apply.
+			addAPIError(&resp.Diagnostics, "Error activating certificate", "Could not activate certificate "+certificate.ID+": ", err)
+			plan.Active = types.BoolValue(false)
+		}
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state certificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// The activation of a certificate is only reported by the listing of the
+	// certificates of the organization or the project.
+	certificate, ok, err := r.client.findCertificate(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI certificate", "Could not read OpenAI certificate ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refresh(certificate)
+	state.Active = types.BoolValue(certificate.Active)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *certificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state certificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	// Only the name and the activation can be updated, a change of the
+	// content uploads a new certificate.
+	if !plan.Name.Equal(state.Name) {
+		var certificate certificateObject
+		err := r.client.doJSON(ctx, http.MethodPost, "/organization/certificates/"+plan.ID.ValueString(), certificateRequest{
+			Name: plan.Name.ValueString(),
+		}, &certificate)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error Updating OpenAI certificate", "Could not rename certificate, unexpected error: ", err)
+			return
+		}
+	}
+
+	if !plan.Active.Equal(state.Active) {
+		err := r.client.setCertificateActive(ctx, plan.ProjectID.ValueString(), plan.ID.ValueString(), plan.Active.ValueBool())
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error Updating OpenAI certificate", "Could not change the activation of certificate, unexpected error: ", err)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state certificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Active certificates cannot be deleted
+	if state.Active.ValueBool() {
+		err := r.client.setCertificateActive(ctx, state.ProjectID.ValueString(), state.ID.ValueString(), false)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error Deleting OpenAI certificate", "Could not deactivate certificate, unexpected error: ", err)
+			return
+		}
+	}
+
+	// Delete existing certificate
+	err := r.client.doJSON(ctx, http.MethodDelete, "/organization/certificates/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI certificate", "Could not delete certificate, unexpected error: ", err)
+		return
+	}
+}
+
+// refresh populates the computed attributes from the certificate returned by
+// the API.
+func (m *certificateResourceModel) refresh(certificate certificateObject) {
+	m.ID = types.StringValue(certificate.ID)
+	m.ValidAt = types.Int64Value(certificate.CertificateDetails.ValidAt)
+	m.ExpiresAt = types.Int64Value(certificate.CertificateDetails.ExpiresAt)
+	m.CreatedAt = types.Int64Value(certificate.CreatedAt)
+
+	if certificate.Name != "" || !m.Name.IsNull() {
+		m.Name = types.StringValue(certificate.Name)
+	}
+}
+
+// certificatesPath returns the path of the certificates of the project, or of
+// the organization when projectID is empty.
+func certificatesPath(projectID string) string {
+	if projectID == "" {
+		return "/organization/certificates"
+	}
+
+	return "/organization/projects/" + projectID + "/certificates"
+}
+
+// setCertificateActive activates or deactivates the certificate for the
+// project, or for the organization when projectID is empty.
+func (c *openaiClient) setCertificateActive(ctx context.Context, projectID, certificateID string, active bool) error {
+	action := "/deactivate"
+	if active {
+		action = "/activate"
+	}
+
+	return c.doJSON(ctx, http.MethodPost, certificatesPath(projectID)+action, map[string][]string{
+		"certificate_ids": {certificateID},
+	}, nil)
+}
+
+// findCertificate looks up the certificate among the certificates of the
+// project, or of the organization when projectID is empty.
+func (c *openaiClient) findCertificate(ctx context.Context, projectID, certificateID string) (certificateObject, bool, error) {
+	query := url.Values{"limit": {"100"}}
+	for {
+		var page struct {
+			Data    []certificateObject `json:"data"`
+			HasMore bool                `json:"has_more"`
+			LastID  string              `json:"last_id"`
+		}
+		if err := c.doJSON(ctx, http.MethodGet, certificatesPath(projectID)+"?"+query.Encode(), nil, &page); err != nil {
+			return certificateObject{}, false, err
+		}
+
+		for _, certificate := range page.Data {
+			if certificate.ID == certificateID {
+				return certificate, true, nil
+			}
+		}
+		if !page.HasMore || page.LastID == "" {
+			return certificateObject{}, false, nil
+		}
+		query.Set("after", page.LastID)
+	}
+}
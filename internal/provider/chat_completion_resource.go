@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &chatCompletionResource{}
+	_ resource.ResourceWithConfigure  = &chatCompletionResource{}
+	_ resource.ResourceWithModifyPlan = &chatCompletionResource{}
+)
+
+// NewChatCompletionResource is a helper function to simplify the provider implementation.
+func NewChatCompletionResource() resource.Resource {
+	return &chatCompletionResource{}
+}
+
+// chatCompletionResource is the resource implementation.
+type chatCompletionResource struct {
+	client *openaiClient
+}
+
+// chatCompletionResourceModel maps the resource schema data.
+type chatCompletionResourceModel struct {
+	ID                  types.String                 `tfsdk:"id"`
+	Model               types.String                 `tfsdk:"model"`
+	Messages            []chatCompletionMessageModel `tfsdk:"messages"`
+	Temperature         types.Float64                `tfsdk:"temperature"`
+	TopP                types.Float64                `tfsdk:"top_p"`
+	MaxTokens           types.Int64                  `tfsdk:"max_tokens"`
+	MaxCompletionTokens types.Int64                  `tfsdk:"max_completion_tokens"`
+	Stop                types.List                   `tfsdk:"stop"`
+	Seed                types.Int64                  `tfsdk:"seed"`
+	PresencePenalty     types.Float64                `tfsdk:"presence_penalty"`
+	FrequencyPenalty    types.Float64                `tfsdk:"frequency_penalty"`
+	LogitBias           types.Map                    `tfsdk:"logit_bias"`
+	Triggers            types.Map                    `tfsdk:"triggers"`
+	Content             types.String                 `tfsdk:"content"`
+	FinishReason        types.String                 `tfsdk:"finish_reason"`
+	SystemFingerprint   types.String                 `tfsdk:"system_fingerprint"`
+	Project             types.String                 `tfsdk:"project"`
+	Timeouts            timeouts.Value               `tfsdk:"timeouts"`
+}
+
+// chatCompletionMessageModel maps a single chat message.
+type chatCompletionMessageModel struct {
+	Role    types.String `tfsdk:"role"`
+	Content types.String `tfsdk:"content"`
+}
+
+// chatCompletionRequest is the body of a chat completion request. The SDK
+// request omits zero values, which makes a temperature or a penalty of 0
+// impossible to send, and does not know about max_completion_tokens.
+type chatCompletionRequest struct {
+	Model               string                         `json:"model"`
+	Messages            []openai.ChatCompletionMessage `json:"messages"`
+	Temperature         *float64                       `json:"temperature,omitempty"`
+	TopP                *float64                       `json:"top_p,omitempty"`
+	MaxTokens           *int64                         `json:"max_tokens,omitempty"`
+	MaxCompletionTokens *int64                         `json:"max_completion_tokens,omitempty"`
+	Stop                []string                       `json:"stop,omitempty"`
+	Seed                *int64                         `json:"seed,omitempty"`
+	PresencePenalty     *float64                       `json:"presence_penalty,omitempty"`
+	FrequencyPenalty    *float64                       `json:"frequency_penalty,omitempty"`
+	LogitBias           map[string]int64               `json:"logit_bias,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *chatCompletionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chat_completion"
+}
+
+// Schema defines the schema for the resource.
+func (r *chatCompletionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a chat completion once and keeps the result in state. The completion is only generated again when one of its inputs, or one of the `triggers`, changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the chat completion.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model": schema.StringAttribute{
+				Description: "Model used to generate the completion.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"messages": schema.ListNestedAttribute{
+				Description: "Messages comprising the conversation so far.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the message author, either `system`, `developer`, `user` or `assistant`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("system", "developer", "user", "assistant"),
+							},
+						},
+						"content": schema.StringAttribute{
+							Description: "Content of the message.",
+							Required:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"temperature": schema.Float64Attribute{
+				Description: "Sampling temperature to use, between 0 and 2. Higher values make the output more random, lower values make it more focused and deterministic.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 2),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"top_p": schema.Float64Attribute{
+				Description: "Nucleus sampling, the model only considers the tokens comprising the top_p probability mass.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"max_tokens": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of tokens to generate. Not supported by reasoning models, use `max_completion_tokens` instead.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"max_completion_tokens": schema.Int64Attribute{
+				MarkdownDescription: "Upper bound for the number of tokens generated, including reasoning tokens. Conflicts with `max_tokens`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("max_tokens")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"stop": schema.ListAttribute{
+				Description: "Up to 4 sequences where the API will stop generating further tokens.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(4),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"seed": schema.Int64Attribute{
+				MarkdownDescription: "Seed used to sample deterministically. Repeated requests with the same seed and parameters should return the same result, compare `system_fingerprint` to detect backend changes.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"presence_penalty": schema.Float64Attribute{
+				Description: "Number between -2.0 and 2.0. Positive values penalize new tokens based on whether they appear in the text so far.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(-2, 2),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"frequency_penalty": schema.Float64Attribute{
+				Description: "Number between -2.0 and 2.0. Positive values penalize new tokens based on their existing frequency in the text so far.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(-2, 2),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"logit_bias": schema.MapAttribute{
+				Description: "Map of token IDs to a bias value from -100 to 100, modifying the likelihood of those tokens appearing in the completion.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.ValueInt64sAre(int64validator.Between(-100, 100)),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will generate a new completion.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Content of the generated message.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"finish_reason": schema.StringAttribute{
+				Description: "Reason the model stopped generating tokens.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_fingerprint": schema.StringAttribute{
+				Description: "Fingerprint of the backend configuration the model ran with.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the completion is billed to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *chatCompletionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the model is available to the API key.
+func (r *chatCompletionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.modifyPlanModel(ctx, req, resp)
+}
+
+// Create a new resource.
+func (r *chatCompletionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan chatCompletionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	completionRequest := chatCompletionRequest{
+		Model:               plan.Model.ValueString(),
+		Messages:            []openai.ChatCompletionMessage{},
+		Temperature:         plan.Temperature.ValueFloat64Pointer(),
+		TopP:                plan.TopP.ValueFloat64Pointer(),
+		MaxTokens:           plan.MaxTokens.ValueInt64Pointer(),
+		MaxCompletionTokens: plan.MaxCompletionTokens.ValueInt64Pointer(),
+		Seed:                plan.Seed.ValueInt64Pointer(),
+		PresencePenalty:     plan.PresencePenalty.ValueFloat64Pointer(),
+		FrequencyPenalty:    plan.FrequencyPenalty.ValueFloat64Pointer(),
+	}
+
+	for _, message := range plan.Messages {
+		completionRequest.Messages = append(completionRequest.Messages, openai.ChatCompletionMessage{
+			Role:    message.Role.ValueString(),
+			Content: message.Content.ValueString(),
+		})
+	}
+
+	if !plan.Stop.IsNull() {
+		diags = plan.Stop.ElementsAs(ctx, &completionRequest.Stop, false)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if !plan.LogitBias.IsNull() {
+		diags = plan.LogitBias.ElementsAs(ctx, &completionRequest.LogitBias, false)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var completion openai.ChatCompletionResponse
+	err := r.client.doJSON(ctx, http.MethodPost, "/chat/completions", completionRequest, &completion)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error creating chat completion", "Could not create chat completion, unexpected error: ", err)
+		return
+	}
+
+	if len(completion.Choices) == 0 {
+		resp.Diagnostics.AddError(
+			"Error creating chat completion",
+			"Could not create chat completion, the API returned no choices.",
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(completion.ID)
+	plan.Content = types.StringValue(completion.Choices[0].Message.Content)
+	plan.FinishReason = types.StringValue(string(completion.Choices[0].FinishReason))
+	plan.SystemFingerprint = types.StringValue(completion.SystemFingerprint)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+//
+// Chat completions cannot be retrieved from the API once generated, the
+// previous output is kept as is in state.
+func (r *chatCompletionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state chatCompletionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *chatCompletionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every input requires a replacement, there is nothing to send to OpenAI.
+	var plan chatCompletionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *chatCompletionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Chat completions are not stored by OpenAI, removing the resource from
+	// state is enough.
+}
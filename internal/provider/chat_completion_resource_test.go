@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChatCompletionResource(t *testing.T) {
+	server := testAccPreCheck(t)
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrSet("openai_chat_completion.test", "id"),
+		resource.TestCheckResourceAttrSet("openai_chat_completion.test", "content"),
+		resource.TestCheckResourceAttr("openai_chat_completion.test", "finish_reason", "stop"),
+	}
+	// The mock answers with the content of the last message.
+	if server != nil {
+		checks = append(checks, resource.TestCheckResourceAttr("openai_chat_completion.test", "content", "Say pong."))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "openai_chat_completion" "test" {
+  model       = "gpt-4o-mini"
+  temperature = 0
+  messages = [{
+    role    = "user"
+    content = "Say pong."
+  }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccChatCompletionResourceValidation(t *testing.T) {
+	testAccPreCheck(t)
+
+	tests := map[string]struct {
+		attributes string
+		expected   *regexp.Regexp
+	}{
+		"temperature": {
+			attributes: "temperature = 3",
+			expected:   regexp.MustCompile(`(?s)Invalid Attribute Value.*temperature = 3`),
+		},
+		"logit_bias": {
+			attributes: `logit_bias = { "50256" = -101 }`,
+			expected:   regexp.MustCompile(`(?s)Invalid Attribute Value.*logit_bias`),
+		},
+		"max_tokens": {
+			attributes: "max_tokens = 16\n  max_completion_tokens = 16",
+			expected:   regexp.MustCompile(`Invalid Attribute Combination`),
+		},
+		"stop": {
+			attributes: `stop = ["a", "b", "c", "d", "e"]`,
+			expected:   regexp.MustCompile(`(?s)Invalid Attribute Value.*stop`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: `
+resource "openai_chat_completion" "test" {
+  model = "gpt-4o-mini"
+  messages = [{
+    role    = "user"
+    content = "Say pong."
+  }]
+  ` + test.attributes + `
+}
+`,
+						PlanOnly:    true,
+						ExpectError: test.expected,
+					},
+				},
+			})
+		})
+	}
+}
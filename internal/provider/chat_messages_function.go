@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &chatMessagesFunction{}
+)
+
+// NewChatMessagesFunction is a helper function to simplify the provider implementation.
+func NewChatMessagesFunction() function.Function {
+	return &chatMessagesFunction{}
+}
+
+// chatMessagesFunction is the function implementation.
+type chatMessagesFunction struct{}
+
+// Metadata returns the function name.
+func (f *chatMessagesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "chat_messages"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *chatMessagesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds the messages of a chat completion",
+		MarkdownDescription: "Builds the `messages` of `openai_chat_completion` from the system message, the first user message, then the following messages of the conversation, alternately from the assistant and from the user.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "system",
+				MarkdownDescription: "Content of the system message. No system message is added when empty.",
+			},
+			function.StringParameter{
+				Name:        "user",
+				Description: "Content of the first user message.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "turns",
+			Description: "Contents of the following messages, alternately from the assistant and from the user.",
+		},
+		Return: function.ListReturn{
+			ElementType: chatMessageType,
+		},
+	}
+}
+
+// Run builds the messages.
+func (f *chatMessagesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var system, user string
+	var turns []string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &system, &user, &turns)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var messages []chatCompletionMessageModel
+	if system != "" {
+		messages = append(messages, chatMessage("system", system))
+	}
+	messages = append(messages, chatMessage("user", user))
+	for i, content := range turns {
+		role := "assistant"
+		if i%2 == 1 {
+			role = "user"
+		}
+		messages = append(messages, chatMessage(role, content))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, messages)...)
+}
+
+// chatMessage returns a message of a chat completion.
+func chatMessage(role, content string) chatCompletionMessageModel {
+	return chatCompletionMessageModel{
+		Role:    types.StringValue(role),
+		Content: types.StringValue(content),
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestChatMessagesFunction(t *testing.T) {
+	tests := map[string]struct {
+		system   string
+		turns    []string
+		expected []chatCompletionMessageModel
+	}{
+		"system message": {
+			system: "You are terse.",
+			expected: []chatCompletionMessageModel{
+				chatMessage("system", "You are terse."),
+				chatMessage("user", "Hello"),
+			},
+		},
+		"no system message": {
+			expected: []chatCompletionMessageModel{
+				chatMessage("user", "Hello"),
+			},
+		},
+		"conversation": {
+			system: "You are terse.",
+			turns:  []string{"Hi", "How are you?"},
+			expected: []chatCompletionMessageModel{
+				chatMessage("system", "You are terse."),
+				chatMessage("user", "Hello"),
+				chatMessage("assistant", "Hi"),
+				chatMessage("user", "How are you?"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var turns []attr.Value
+			for _, turn := range test.turns {
+				turns = append(turns, types.StringValue(turn))
+			}
+
+			result, diags := runFunction(t, NewChatMessagesFunction(), types.StringValue(test.system), types.StringValue("Hello"), types.ListValueMust(types.StringType, turns))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			var messages []chatCompletionMessageModel
+			if diags := result.(types.List).ElementsAs(context.Background(), &messages, false); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if len(messages) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, messages)
+			}
+			for i, message := range messages {
+				if message != test.expected[i] {
+					t.Errorf("expected message %d to be %v, got %v", i, test.expected[i], message)
+				}
+			}
+		})
+	}
+}
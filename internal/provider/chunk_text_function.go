@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pkoukk/tiktoken-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &chunkTextFunction{}
+)
+
+// NewChunkTextFunction is a helper function to simplify the provider implementation.
+func NewChunkTextFunction() function.Function {
+	return &chunkTextFunction{}
+}
+
+// chunkTextFunction is the function implementation.
+type chunkTextFunction struct{}
+
+// Metadata returns the function name.
+func (f *chunkTextFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "chunk_text"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *chunkTextFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Splits a text in chunks of tokens",
+		MarkdownDescription: "Splits a long text in chunks of at most `max_tokens` tokens, each chunk repeating the last `overlap` tokens of the previous one. The text is tokenized with the `cl100k_base` encoding of the `text-embedding-3` models, so the chunks can be embedded with `openai_embedding` or uploaded to a vector store.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "text",
+				Description: "Text to split.",
+			},
+			function.Int64Parameter{
+				Name:        "max_tokens",
+				Description: "Maximum number of tokens of a chunk, at least 1.",
+			},
+			function.Int64Parameter{
+				Name:                "overlap",
+				MarkdownDescription: "Number of tokens shared by two consecutive chunks, from 0 to `max_tokens` - 1.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run splits the text in chunks.
+func (f *chunkTextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text string
+	var maxTokens, overlap int64
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &text, &maxTokens, &overlap)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if maxTokens < 1 {
+		resp.Diagnostics.AddArgumentError(1, "Invalid max tokens", fmt.Sprintf("max_tokens must be at least 1, got %d.", maxTokens))
+	}
+	if overlap < 0 || overlap >= max(maxTokens, 1) {
+		resp.Diagnostics.AddArgumentError(2, "Invalid overlap", fmt.Sprintf("overlap must be between 0 and max_tokens - 1, got %d.", overlap))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenizer, err := encodingTokenizer(tiktoken.MODEL_CL100K_BASE)
+	if err != nil {
+		resp.Diagnostics.AddError("Error loading the tokenizer", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, chunkTokens(tokenizer, text, int(maxTokens), int(overlap)))...)
+}
+
+// chunkTokens splits text in chunks of at most maxTokens tokens, overlapping
+// by overlap tokens. A character may be encoded with several tokens, the
+// boundaries of the chunks are moved so they do not split a character.
+func chunkTokens(tokenizer *tiktoken.Tiktoken, text string, maxTokens, overlap int) []string {
+	tokens := tokenizer.EncodeOrdinary(text)
+	chunks := []string{}
+
+	for start := 0; start < len(tokens); {
+		// The end is moved back to the end of a character, or forward when a
+		// single character is longer than maxTokens.
+		end := min(start+maxTokens, len(tokens))
+		for end > start+1 && end < len(tokens) && !utf8.ValidString(tokenizer.Decode(tokens[start:end])) {
+			end--
+		}
+		for end < len(tokens) && !utf8.ValidString(tokenizer.Decode(tokens[start:end])) {
+			end++
+		}
+		chunks = append(chunks, tokenizer.Decode(tokens[start:end]))
+
+		if end == len(tokens) {
+			break
+		}
+
+		// The next chunk starts at the first token starting a character, and
+		// always after the start of this chunk.
+		next := max(end-overlap, start+1)
+		for next < end && !utf8.ValidString(tokenizer.Decode(tokens[next:end])) {
+			next++
+		}
+		start = next
+	}
+
+	return chunks
+}
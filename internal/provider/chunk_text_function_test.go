@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestChunkTextFunction(t *testing.T) {
+	tests := map[string]struct {
+		text      string
+		maxTokens int64
+		overlap   int64
+		expected  []string
+	}{
+		"overlap": {
+			text:      "one two three four five six seven eight nine ten",
+			maxTokens: 4,
+			overlap:   1,
+			expected:  []string{"one two three four", " four five six seven", " seven eight nine ten"},
+		},
+		"no overlap": {
+			text:      "one two three four five",
+			maxTokens: 2,
+			expected:  []string{"one two", " three four", " five"},
+		},
+		"short text": {
+			text:      "one two",
+			maxTokens: 800,
+			overlap:   400,
+			expected:  []string{"one two"},
+		},
+		"empty text": {
+			maxTokens: 800,
+			expected:  []string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := runFunction(t, NewChunkTextFunction(), types.StringValue(test.text), types.Int64Value(test.maxTokens), types.Int64Value(test.overlap))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			var chunks []string
+			if diags := result.(types.List).ElementsAs(context.Background(), &chunks, false); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if strings.Join(chunks, "|") != strings.Join(test.expected, "|") || len(chunks) != len(test.expected) {
+				t.Errorf("expected chunks %q, got %q", test.expected, chunks)
+			}
+		})
+	}
+
+	// The characters encoded with several tokens are not split.
+	result, diags := runFunction(t, NewChunkTextFunction(), types.StringValue("🙂🚀🙂 déjà vu"), types.Int64Value(1), types.Int64Value(0))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	var chunks []string
+	result.(types.List).ElementsAs(context.Background(), &chunks, false)
+	for _, chunk := range chunks {
+		if !utf8.ValidString(chunk) {
+			t.Errorf("expected valid UTF-8 chunks, got %q", chunks)
+		}
+	}
+	if strings.Join(chunks, "") != "🙂🚀🙂 déjà vu" {
+		t.Errorf("expected the chunks to join to the text, got %q", chunks)
+	}
+
+	for name, args := range map[string][2]int64{
+		"max tokens":     {0, 0},
+		"overlap":        {10, 10},
+		"negative value": {10, -1},
+	} {
+		if _, diags := runFunction(t, NewChunkTextFunction(), types.StringValue("text"), types.Int64Value(args[0]), types.Int64Value(args[1])); !diags.HasError() {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// openaiClient is the client shared by all resources and data sources. It
+// embeds the go-openai client and adds helpers to call the endpoints, or the
+// request parameters, the SDK does not support yet.
+type openaiClient struct {
+	*openai.Client
+
+	apiKey       string
+	adminAPIKey  string
+	organization string
+	baseURL      string
+	httpClient   *http.Client
+	quota        *quotaTransport
+
+	// uploadPartSize and uploadConcurrency configure the uploads of the
+	// large files, see uploadFileInParts.
+	uploadPartSize    int
+	uploadConcurrency int
+}
+
+// readAfterWriteTimeout bounds the retries of a read following the creation
+// or update of an object, and readAfterWriteInterval is the interval between
+// two attempts.
+const (
+	readAfterWriteTimeout  = 10 * time.Second
+	readAfterWriteInterval = time.Second
+)
+
+// openaiClientOptions configures the client shared by all resources and data
+// sources.
+type openaiClientOptions struct {
+	// APIKey authenticates the requests.
+	APIKey string
+	// AdminAPIKey authenticates the requests of the Administration API,
+	// instead of APIKey.
+	AdminAPIKey string
+	// BaseURL is the URL the requests are sent to.
+	BaseURL string
+	// Organization is the organization the requests are sent to.
+	Organization string
+	// Project is the project the requests are sent to, unless overridden with
+	// withProject.
+	Project string
+	// QuotaWarningThreshold is the utilization of the rate limits above which
+	// a warning is shown after a change.
+	QuotaWarningThreshold float64
+	// MaxRetries is the number of retries of the requests failing with a
+	// transient error, and RetryMaxDelay the maximum delay before a retry.
+	MaxRetries    int
+	RetryMaxDelay time.Duration
+	// RequestsPerMinute is the maximum number of requests sent per minute,
+	// including the retries. The requests are not throttled when it is 0.
+	RequestsPerMinute int
+	// RequestTimeout is the timeout of each attempt of a request. The
+	// requests have no timeout of their own when it is 0.
+	RequestTimeout time.Duration
+	// DefaultHeaders are added to every request, unless set by the provider.
+	DefaultHeaders map[string]string
+	// UploadPartSize is the size, in bytes, of the parts of the files
+	// uploaded with the Uploads API, and UploadConcurrency the number of parts
+	// uploaded at once.
+	UploadPartSize    int
+	UploadConcurrency int
+}
+
+// newOpenAIClient creates the client shared by all resources and data sources.
+func newOpenAIClient(options openaiClientOptions) *openaiClient {
+	timeout := newTimeoutTransport(http.DefaultTransport, options.RequestTimeout)
+	throttle := newThrottleTransport(timeout, options.RequestsPerMinute)
+	retry := newRetryTransport(throttle, options.MaxRetries, options.RetryMaxDelay)
+	quota := newQuotaTransport(retry, options.QuotaWarningThreshold)
+
+	config := openai.DefaultConfig(options.APIKey)
+	if options.BaseURL != "" {
+		config.BaseURL = strings.TrimSuffix(options.BaseURL, "/")
+	}
+	config.OrgID = options.Organization
+	config.HTTPClient = &http.Client{Transport: &projectTransport{
+		next:    newHeadersTransport(newReadCacheTransport(quota), options.DefaultHeaders),
+		project: options.Project,
+	}}
+
+	return &openaiClient{
+		Client:       openai.NewClientWithConfig(config),
+		apiKey:       options.APIKey,
+		adminAPIKey:  options.AdminAPIKey,
+		organization: options.Organization,
+		baseURL:      config.BaseURL,
+		httpClient:   config.HTTPClient,
+		quota:        quota,
+
+		uploadPartSize:    options.UploadPartSize,
+		uploadConcurrency: options.UploadConcurrency,
+	}
+}
+
+// newRequest creates an authenticated request against the OpenAI API. The
+// path is relative to the API base URL, e.g. "/chat/completions".
+func (c *openaiClient) newRequest(ctx context.Context, method, path string, body io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.baseURL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey := c.apiKey
+	if isAdminPath(path) && c.adminAPIKey != "" {
+		apiKey = c.adminAPIKey
+	}
+
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	req.Header.Set("Accept", "application/json")
+	if c.organization != "" {
+		req.Header.Set("OpenAI-Organization", c.organization)
+	}
+	// Requests to the Assistants API use its v2, which must be opted in.
+	if strings.HasPrefix(path, "/threads") || strings.HasPrefix(path, "/assistants") {
+		req.Header.Set("OpenAI-Beta", "assistants=v2")
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	return req, nil
+}
+
+// isAdminPath reports whether the path, relative to the API base URL or
+// absolute, is an endpoint of the Administration API.
+func isAdminPath(path string) bool {
+	return strings.HasPrefix(strings.TrimPrefix(path, "/v1"), "/organization/")
+}
+
+// adminAPIKeyPrefix is the prefix of the admin API keys, the keys of the
+// organization allowed to call the Administration API.
+const adminAPIKeyPrefix = "sk-admin-"
+
+// requireAdminAPIKey adds an error when the client has no admin API key, for
+// the resources and data sources calling the Administration API, as the API
+// keys of the projects are rejected with a less helpful authentication error.
+func (c *openaiClient) requireAdminAPIKey(diags *diag.Diagnostics, typeName string) {
+	if c.adminAPIKey != "" {
+		return
+	}
+
+	diags.AddError(
+		"Missing OpenAI admin API key",
+		fmt.Sprintf("%s uses the Administration API of OpenAI, which requires an admin API key of the organization. "+
+			"Set the admin_api_key value in the provider configuration or use the OPENAI_ADMIN_KEY environment variable. "+
+			"Admin API keys are created in the organization settings of the OpenAI dashboard, by the owners of the organization.", typeName),
+	)
+}
+
+// doRequest sends the request and decodes the JSON response body into out,
+// when out is not nil. A *[]byte out receives the raw response body instead,
+// for the endpoints returning files. API errors are returned as
+// *openai.APIError, the same way the SDK reports them.
+func (c *openaiClient) doRequest(req *http.Request, out any) error {
+	_, err := c.doRequestHeader(req, out)
+	return err
+}
+
+// doRequestHeader is doRequest also returning the headers of the response,
+// for the information the API only reports in headers.
+func (c *openaiClient) doRequestHeader(req *http.Request, out any) (http.Header, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return resp.Header, decodeErrorResponse(resp)
+	}
+
+	if out == nil {
+		return resp.Header, nil
+	}
+
+	if raw, ok := out.(*[]byte); ok {
+		*raw, err = io.ReadAll(resp.Body)
+		return resp.Header, err
+	}
+
+	return resp.Header, json.NewDecoder(resp.Body).Decode(out)
+}
+
+// doJSON sends in as the JSON body of a request and decodes the JSON response
+// into out. Either of them may be nil.
+func (c *openaiClient) doJSON(ctx context.Context, method, path string, in, out any) error {
+	_, err := c.doJSONHeader(ctx, method, path, in, out)
+	return err
+}
+
+// doJSONHeader is doJSON also returning the headers of the response.
+func (c *openaiClient) doJSONHeader(ctx context.Context, method, path string, in, out any) (http.Header, error) {
+	var body io.Reader
+	contentType := ""
+
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	req, err := c.newRequest(ctx, method, path, body, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRequestHeader(req, out)
+}
+
+// multipartFile is a file sent in a multipart/form-data request, either its
+// content or a section streamed from a local file, as the large files are not
+// read in memory.
+type multipartFile struct {
+	field   string
+	name    string
+	content []byte
+	section *io.SectionReader
+}
+
+// sniffLength is the number of bytes http.DetectContentType considers.
+const sniffLength = 512
+
+// head returns the first bytes of the file, to detect its content type.
+func (f multipartFile) head() []byte {
+	if f.section == nil {
+		return f.content
+	}
+
+	head := make([]byte, min(sniffLength, f.section.Size()))
+	n, _ := f.section.ReadAt(head, 0)
+	return head[:n]
+}
+
+// quoteEscaper escapes the field and file names of multipart headers.
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// doMultipart sends the fields and files as a multipart/form-data request and
+// decodes the JSON response into out. Empty field values are not sent. The content
+// type of the files is guessed from their name, or else from their content, as
+// some endpoints reject application/octet-stream. The sections of the files are
+// streamed between the buffered multipart headers, and read again when the
+// request is retried.
+func (c *openaiClient) doMultipart(ctx context.Context, path string, fields url.Values, files []multipartFile, out any) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	// offsets are the positions in body the sections are streamed at.
+	var offsets []int
+	var sections []*io.SectionReader
+	for _, file := range files {
+		contentType := mime.TypeByExtension(filepath.Ext(file.name))
+		if contentType == "" {
+			contentType = http.DetectContentType(file.head())
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(file.field), quoteEscaper.Replace(file.name)))
+		header.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return err
+		}
+		if file.section != nil {
+			offsets = append(offsets, body.Len())
+			sections = append(sections, file.section)
+			continue
+		}
+		if _, err := part.Write(file.content); err != nil {
+			return err
+		}
+	}
+
+	for name, values := range fields {
+		for _, value := range values {
+			if value == "" {
+				continue
+			}
+			if err := writer.WriteField(name, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	contentLength := int64(body.Len())
+	for _, section := range sections {
+		contentLength += section.Size()
+	}
+	getBody := func() (io.ReadCloser, error) {
+		data := body.Bytes()
+		var readers []io.Reader
+		start := 0
+		for i, section := range sections {
+			readers = append(readers, bytes.NewReader(data[start:offsets[i]]), io.NewSectionReader(section, 0, section.Size()))
+			start = offsets[i]
+		}
+		readers = append(readers, bytes.NewReader(data[start:]))
+		return io.NopCloser(io.MultiReader(readers...)), nil
+	}
+
+	requestBody, _ := getBody()
+	req, err := c.newRequest(ctx, http.MethodPost, path, requestBody, writer.FormDataContentType())
+	if err != nil {
+		return err
+	}
+	req.ContentLength = contentLength
+	req.GetBody = getBody
+
+	return c.doRequest(req, out)
+}
+
+// decodeErrorResponse converts an unsuccessful API response to an error.
+func decodeErrorResponse(resp *http.Response) error {
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &openai.RequestError{HTTPStatusCode: resp.StatusCode, Err: err}
+	}
+
+	var errResp openai.ErrorResponse
+	if err := json.Unmarshal(data, &errResp); err != nil || errResp.Error == nil {
+		return &openai.RequestError{
+			HTTPStatusCode: resp.StatusCode,
+			Err:            fmt.Errorf("unexpected response: %s", strings.TrimSpace(string(data))),
+		}
+	}
+
+	errResp.Error.HTTPStatusCode = resp.StatusCode
+	return errResp.Error
+}
+
+// errorStatusCode returns the HTTP status code of an error returned by the
+// client, or 0 when the request did not get a response.
+func errorStatusCode(err error) int {
+	var apiErr *openai.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.HTTPStatusCode
+	}
+
+	var reqErr *openai.RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.HTTPStatusCode
+	}
+
+	return 0
+}
+
+// retryNotFound calls read until it succeeds, retrying for up to
+// readAfterWriteTimeout while it fails with a 404 Not Found: objects are not
+// always readable right after they are created or updated.
+func retryNotFound(ctx context.Context, read func(context.Context) error) error {
+	deadline := time.Now().Add(readAfterWriteTimeout)
+
+	for {
+		err := read(ctx)
+		if err == nil || errorStatusCode(err) != http.StatusNotFound || time.Now().After(deadline) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(readAfterWriteInterval):
+		}
+	}
+}
+
+// waitReadable waits for the object at path to be readable after its
+// creation or update, so the resources depending on it do not fail with a
+// 404 Not Found.
+func (c *openaiClient) waitReadable(ctx context.Context, path string) error {
+	return retryNotFound(ctx, func(ctx context.Context) error {
+		return c.doJSON(ctx, http.MethodGet, path, nil, nil)
+	})
+}
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &containerFileResource{}
+	_ resource.ResourceWithConfigure      = &containerFileResource{}
+	_ resource.ResourceWithImportState    = &containerFileResource{}
+	_ resource.ResourceWithModifyPlan     = &containerFileResource{}
+	_ resource.ResourceWithValidateConfig = &containerFileResource{}
+)
+
+// NewContainerFileResource is a helper function to simplify the provider implementation.
+func NewContainerFileResource() resource.Resource {
+	return &containerFileResource{}
+}
+
+// containerFileResource is the resource implementation.
+type containerFileResource struct {
+	client *openaiClient
+}
+
+// containerFileResourceModel maps the resource schema data.
+type containerFileResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	ContainerID  types.String   `tfsdk:"container_id"`
+	SourcePath   types.String   `tfsdk:"source_path"`
+	FileID       types.String   `tfsdk:"file_id"`
+	SourceSHA256 types.String   `tfsdk:"source_sha256"`
+	Path         types.String   `tfsdk:"path"`
+	Bytes        types.Int64    `tfsdk:"bytes"`
+	CreatedAt    types.Int64    `tfsdk:"created_at"`
+	Project      types.String   `tfsdk:"project"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// containerFileRequest is the body of a request copying an uploaded file into
+// a container.
+type containerFileRequest struct {
+	FileID string `json:"file_id"`
+}
+
+// containerFileObject is a container file returned by the Containers API.
+type containerFileObject struct {
+	ID          string `json:"id"`
+	ContainerID string `json:"container_id"`
+	Path        string `json:"path"`
+	Bytes       int64  `json:"bytes"`
+	CreatedAt   int64  `json:"created_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *containerFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container_file"
+}
+
+// Schema defines the schema for the resource.
+func (r *containerFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a file to an `openai_container`, either uploaded from the local filesystem or copied from an uploaded OpenAI file, so the `code_interpreter` tool can read it. The file is uploaded again when the content of the local file changes, and is removed from the container on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the container file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"container_id": schema.StringAttribute{
+				Description: "ID of the container the file is added to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the local file uploaded to the container. Conflicts with `file_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_id": schema.StringAttribute{
+				MarkdownDescription: "ID of an uploaded OpenAI file copied to the container. Conflicts with `source_path`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 checksum of the local file. A change of the checksum uploads the file again. Not set when using `file_id`.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the file within the container, such as `/mnt/data/sales.csv`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bytes": schema.Int64Attribute{
+				Description: "Size of the file, in bytes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the addition of the file to the container.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the container, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *containerFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures exactly one source of the file is set.
+func (r *containerFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config containerFileResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.SourcePath.IsNull() && !config.FileID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_id"),
+			"Conflicting container file source",
+			"Only one of source_path or file_id can be set.",
+		)
+	}
+
+	if config.SourcePath.IsNull() && config.FileID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing container file source",
+			"One of source_path or file_id must be set.",
+		)
+	}
+}
+
+// ModifyPlan computes the checksum of the local file, so a change of its
+// content uploads the file again.
+func (r *containerFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan containerFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.SourcePath.IsUnknown() {
+		return
+	}
+
+	if plan.SourcePath.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), types.StringNull())...)
+		return
+	}
+
+	modifyPlanLocalFilesChecksum(ctx, req, resp, path.Root("source_sha256"), plan.SourcePath.ValueString())
+}
+
+// Create a new resource.
+func (r *containerFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan containerFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	endpoint := "/containers/" + plan.ContainerID.ValueString() + "/files"
+
+	var file containerFileObject
+	plan.SourceSHA256 = types.StringNull()
+
+	if !plan.SourcePath.IsNull() {
+		content, err := os.ReadFile(plan.SourcePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_path"),
+				"Error reading file content",
+				"Could not create container file, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		err = r.client.doMultipart(ctx, endpoint, nil, []multipartFile{
+			{field: "file", name: filepath.Base(plan.SourcePath.ValueString()), content: content},
+		}, &file)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error creating container file", "Could not upload container file, unexpected error: ", err)
+			return
+		}
+
+		plan.SourceSHA256 = types.StringValue(sha256Hex(content))
+	} else {
+		err := r.client.doJSON(ctx, http.MethodPost, endpoint, containerFileRequest{
+			FileID: plan.FileID.ValueString(),
+		}, &file)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error creating container file", "Could not copy file "+plan.FileID.ValueString()+" to the container, unexpected error: ", err)
+			return
+		}
+	}
+
+	// Wait for the container file to be readable, the resources depending on it
+	// would otherwise fail with a 404 Not Found.
+	if err := r.client.waitReadable(ctx, "/containers/"+plan.ContainerID.ValueString()+"/files/"+file.ID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Container file not readable yet",
+			"The container file "+file.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(file.ID)
+	plan.Path = types.StringValue(file.Path)
+	plan.Bytes = types.Int64Value(file.Bytes)
+	plan.CreatedAt = types.Int64Value(file.CreatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *containerFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state containerFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed container file value from OpenAI
+	var file containerFileObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/containers/"+state.ContainerID.ValueString()+"/files/"+state.ID.ValueString(), nil, &file)
+	if removeNotFound(ctx, resp, err, "OpenAI container file "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI container file", "Could not read OpenAI container file ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	state.Path = types.StringValue(file.Path)
+	state.Bytes = types.Int64Value(file.Bytes)
+	state.CreatedAt = types.Int64Value(file.CreatedAt)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *containerFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Container files cannot be updated, any change replaces the file.
+	var plan containerFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *containerFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state containerFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing container file
+	err := r.client.doJSON(ctx, http.MethodDelete, "/containers/"+state.ContainerID.ValueString()+"/files/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI container file", "Could not delete container file, unexpected error: ", err)
+		return
+	}
+}
+
+func (r *containerFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	containerID, fileID, ok := strings.Cut(req.ID, "/")
+	if !ok || containerID == "" || fileID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: container_id/file_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("container_id"), containerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fileID)...)
+}
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &containerResource{}
+	_ resource.ResourceWithConfigure      = &containerResource{}
+	_ resource.ResourceWithImportState    = &containerResource{}
+	_ resource.ResourceWithValidateConfig = &containerResource{}
+)
+
+// NewContainerResource is a helper function to simplify the provider implementation.
+func NewContainerResource() resource.Resource {
+	return &containerResource{}
+}
+
+// containerResource is the resource implementation.
+type containerResource struct {
+	client *openaiClient
+}
+
+// containerResourceModel maps the resource schema data.
+type containerResourceModel struct {
+	ID           types.String                `tfsdk:"id"`
+	Name         types.String                `tfsdk:"name"`
+	FileIDs      types.List                  `tfsdk:"file_ids"`
+	ExpiresAfter *containerExpiresAfterModel `tfsdk:"expires_after"`
+	Status       types.String                `tfsdk:"status"`
+	CreatedAt    types.Int64                 `tfsdk:"created_at"`
+	Project      types.String                `tfsdk:"project"`
+	Timeouts     timeouts.Value              `tfsdk:"timeouts"`
+}
+
+// containerExpiresAfterModel maps the expiration policy of a container.
+type containerExpiresAfterModel struct {
+	Minutes types.Int64 `tfsdk:"minutes"`
+}
+
+// containerRequest is the body of a request creating a container.
+type containerRequest struct {
+	Name         string                 `json:"name"`
+	FileIDs      []string               `json:"file_ids,omitempty"`
+	ExpiresAfter *containerExpiresAfter `json:"expires_after,omitempty"`
+}
+
+// containerExpiresAfter is the expiration policy of a container. Containers
+// can only expire after a period of inactivity.
+type containerExpiresAfter struct {
+	Anchor  string `json:"anchor"`
+	Minutes int64  `json:"minutes"`
+}
+
+// containerObject is a container returned by the Containers API.
+type containerObject struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Status       string                 `json:"status"`
+	CreatedAt    int64                  `json:"created_at"`
+	ExpiresAfter *containerExpiresAfter `json:"expires_after"`
+}
+
+// Metadata returns the resource type name.
+func (r *containerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container"
+}
+
+// Schema defines the schema for the resource.
+func (r *containerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides an OpenAI container, a sandbox the `code_interpreter` tool runs Python code in. Use its ID as the `container_id` of the `code_interpreter` tool of an `openai_response`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the container.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the container.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the files copied into the container on creation. Use `openai_container_file` to manage files individually.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_after": schema.SingleNestedAttribute{
+				Description: "Expiration policy of the container. Defaults to an expiration after 20 minutes of inactivity.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"minutes": schema.Int64Attribute{
+						Description: "Number of minutes, between 1 and 20, of inactivity after which the container expires.",
+						Required:    true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the container, such as `running` or `expired`.",
+				Computed:            true,
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the container.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the container, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *containerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig validates the expiration policy.
+func (r *containerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config containerResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.ExpiresAfter == nil || config.ExpiresAfter.Minutes.IsUnknown() {
+		return
+	}
+
+	if minutes := config.ExpiresAfter.Minutes.ValueInt64(); minutes < 1 || minutes > 20 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_after").AtName("minutes"),
+			"Invalid expiration",
+			fmt.Sprintf("Containers expire after 1 to 20 minutes of inactivity, got: %d.", minutes),
+		)
+	}
+}
+
+// Create a new resource.
+func (r *containerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan containerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	containerRequest := containerRequest{
+		Name: plan.Name.ValueString(),
+	}
+
+	if !plan.FileIDs.IsNull() {
+		diags = plan.FileIDs.ElementsAs(ctx, &containerRequest.FileIDs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.ExpiresAfter != nil {
+		containerRequest.ExpiresAfter = &containerExpiresAfter{
+			Anchor:  "last_active_at",
+			Minutes: plan.ExpiresAfter.Minutes.ValueInt64(),
+		}
+	}
+
+	var container containerObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/containers", containerRequest, &container)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating container", "Could not create container, unexpected error: ", err)
+		return
+	}
+
+	// Wait for the container to be readable, the resources depending on it
+	// would otherwise fail with a 404 Not Found.
+	if err := r.client.waitReadable(ctx, "/containers/"+container.ID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Container not readable yet",
+			"The container "+container.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(container.ID)
+	plan.Status = types.StringValue(container.Status)
+	plan.CreatedAt = types.Int64Value(container.CreatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *containerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state containerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed container value from OpenAI
+	var container containerObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/containers/"+state.ID.ValueString(), nil, &container)
+	if removeNotFound(ctx, resp, err, "OpenAI container "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI container", "Could not read OpenAI container ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	state.Name = types.StringValue(container.Name)
+	state.Status = types.StringValue(container.Status)
+	state.CreatedAt = types.Int64Value(container.CreatedAt)
+
+	// The API reports the default expiration policy, it is only kept when it
+	// was configured.
+	if state.ExpiresAfter != nil && container.ExpiresAfter != nil {
+		state.ExpiresAfter.Minutes = types.Int64Value(container.ExpiresAfter.Minutes)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *containerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Containers cannot be updated, any change replaces the container.
+	var plan containerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *containerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state containerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing container
+	err := r.client.doJSON(ctx, http.MethodDelete, "/containers/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI container", "Could not delete container, unexpected error: ", err)
+		return
+	}
+}
+
+func (r *containerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
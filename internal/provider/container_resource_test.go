@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccContainerResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed("openai_container", "/containers/"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "openai_container" "test" {
+  name = %q
+  expires_after = {
+    minutes = 5
+  }
+}
+`, testAccNamePrefix+"container"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openai_container.test", "id"),
+					resource.TestCheckResourceAttr("openai_container.test", "name", testAccNamePrefix+"container"),
+					resource.TestCheckResourceAttr("openai_container.test", "status", "running"),
+					resource.TestCheckResourceAttr("openai_container.test", "expires_after.minutes", "5"),
+				),
+			},
+			{
+				// The expiration policy is only kept when it was configured.
+				ResourceName:            "openai_container.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"expires_after"},
+			},
+		},
+	})
+}
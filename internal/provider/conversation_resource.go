@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &conversationResource{}
+	_ resource.ResourceWithConfigure = &conversationResource{}
+)
+
+// NewConversationResource is a helper function to simplify the provider implementation.
+func NewConversationResource() resource.Resource {
+	return &conversationResource{}
+}
+
+// conversationResource is the resource implementation.
+type conversationResource struct {
+	client *openaiClient
+}
+
+// conversationResourceModel maps the resource schema data.
+type conversationResourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	Items     []conversationItemModel `tfsdk:"items"`
+	Metadata  types.Map               `tfsdk:"metadata"`
+	CreatedAt types.Int64             `tfsdk:"created_at"`
+	Project   types.String            `tfsdk:"project"`
+	Timeouts  timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// conversationItemModel maps an initial message of the conversation.
+type conversationItemModel struct {
+	Role    types.String `tfsdk:"role"`
+	Content types.String `tfsdk:"content"`
+}
+
+// conversationItem is a message item sent to the Conversations API.
+type conversationItem struct {
+	Type    string `json:"type"`
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// conversationRequest is the body of a request creating or updating a
+// conversation.
+type conversationRequest struct {
+	Items    []conversationItem `json:"items,omitempty"`
+	Metadata map[string]string  `json:"metadata"`
+}
+
+// conversationObject is a conversation returned by the Conversations API.
+type conversationObject struct {
+	ID        string            `json:"id"`
+	CreatedAt int64             `json:"created_at"`
+	Metadata  map[string]string `json:"metadata"`
+}
+
+// Metadata returns the resource type name.
+func (r *conversationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation"
+}
+
+// Schema defines the schema for the resource.
+func (r *conversationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a conversation of the Responses API, to keep the state of a long-lived conversation across responses. The conversation is deleted from OpenAI on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the conversation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Initial messages of the conversation, up to 20.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the author of the message, either `system`, `developer`, `user` or `assistant`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("system", "developer", "user", "assistant"),
+							},
+						},
+						"content": schema.StringAttribute{
+							Description: "Content of the message.",
+							Required:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the conversation.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  metadataValidators(),
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the conversation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the conversation, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *conversationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create a new resource.
+func (r *conversationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan conversationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	conversationRequest := conversationRequest{}
+	for _, item := range plan.Items {
+		conversationRequest.Items = append(conversationRequest.Items, conversationItem{
+			Type:    "message",
+			Role:    item.Role.ValueString(),
+			Content: item.Content.ValueString(),
+		})
+	}
+
+	conversationRequest.Metadata, diags = stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var conversation conversationObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/conversations", conversationRequest, &conversation)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating conversation", "Could not create conversation, unexpected error: ", err)
+		return
+	}
+
+	// Wait for the conversation to be readable, the resources depending on it
+	// would otherwise fail with a 404 Not Found.
+	if err := r.client.waitReadable(ctx, "/conversations/"+conversation.ID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Conversation not readable yet",
+			"The conversation "+conversation.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(conversation.ID)
+	plan.CreatedAt = types.Int64Value(conversation.CreatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *conversationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state conversationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed conversation value from OpenAI
+	var conversation conversationObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/conversations/"+state.ID.ValueString(), nil, &conversation)
+	if removeNotFound(ctx, resp, err, "OpenAI conversation "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI conversation", "Could not read OpenAI conversation ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	state.CreatedAt = types.Int64Value(conversation.CreatedAt)
+	state.Metadata, diags = stringMapFromAPI(ctx, state.Metadata, conversation.Metadata)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *conversationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan conversationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	// Only the metadata can be updated, a change of the items replaces the
+	// conversation.
+	metadata, diags := stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var conversation conversationObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/conversations/"+plan.ID.ValueString(), conversationRequest{
+		Metadata: metadata,
+	}, &conversation)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating OpenAI conversation", "Could not update conversation, unexpected error: ", err)
+		return
+	}
+
+	plan.CreatedAt = types.Int64Value(conversation.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *conversationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state conversationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing conversation
+	err := r.client.doJSON(ctx, http.MethodDelete, "/conversations/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI conversation", "Could not delete conversation, unexpected error: ", err)
+		return
+	}
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConversationResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed("openai_conversation", "/conversations/"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConversationResourceConfig("tests"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openai_conversation.test", "id"),
+					resource.TestCheckResourceAttrSet("openai_conversation.test", "created_at"),
+					resource.TestCheckResourceAttr("openai_conversation.test", "metadata.suite", "tests"),
+				),
+			},
+			{
+				Config: testAccConversationResourceConfig("updated tests"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_conversation.test", "metadata.suite", "updated tests"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConversationResourceConfig(suite string) string {
+	return `
+resource "openai_conversation" "test" {
+  items = [{
+    role    = "user"
+    content = "Hello from the tests of the provider."
+  }]
+  metadata = {
+    suite = "` + suite + `"
+  }
+}
+`
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &cosineSimilarityFunction{}
+)
+
+// NewCosineSimilarityFunction is a helper function to simplify the provider implementation.
+func NewCosineSimilarityFunction() function.Function {
+	return &cosineSimilarityFunction{}
+}
+
+// cosineSimilarityFunction is the function implementation.
+type cosineSimilarityFunction struct{}
+
+// Metadata returns the function name.
+func (f *cosineSimilarityFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cosine_similarity"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *cosineSimilarityFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Computes the cosine similarity of two vectors",
+		MarkdownDescription: "Returns the cosine similarity of two embedding vectors, from -1 to 1, such as the `embeddings` of `openai_embedding`. The vectors must have the same number of dimensions, so a change of the model or of the `dimensions` of the embeddings is reported as an error.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "vec_a",
+				ElementType: types.Float64Type,
+				Description: "First vector.",
+			},
+			function.ListParameter{
+				Name:        "vec_b",
+				ElementType: types.Float64Type,
+				Description: "Second vector.",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+// Run computes the similarity of the vectors.
+func (f *cosineSimilarityFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b []float64
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &a, &b)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(a) != len(b) {
+		resp.Diagnostics.AddArgumentError(1, "Mismatched dimensions", fmt.Sprintf("The vectors must have the same number of dimensions, got %d and %d.", len(a), len(b)))
+		return
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+
+	if normA == 0 || normB == 0 {
+		position := 0
+		if normA != 0 {
+			position = 1
+		}
+		resp.Diagnostics.AddArgumentError(position, "Zero vector", "The cosine similarity of a vector of zeros, or of an empty vector, is undefined.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, dot/(math.Sqrt(normA)*math.Sqrt(normB)))...)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vector returns a list of float64 values.
+func vector(values ...float64) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.Float64Value(value)
+	}
+
+	return types.ListValueMust(types.Float64Type, elements)
+}
+
+func TestCosineSimilarityFunction(t *testing.T) {
+	tests := map[string]struct {
+		a, b     types.List
+		expected float64
+	}{
+		"same direction": {
+			a:        vector(1, 2, 3),
+			b:        vector(2, 4, 6),
+			expected: 1,
+		},
+		"orthogonal": {
+			a:        vector(1, 0),
+			b:        vector(0, 1),
+			expected: 0,
+		},
+		"opposite": {
+			a:        vector(1, -1),
+			b:        vector(-1, 1),
+			expected: -1,
+		},
+		"angle": {
+			a:        vector(1, 0),
+			b:        vector(1, 1),
+			expected: math.Sqrt2 / 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := runFunction(t, NewCosineSimilarityFunction(), test.a, test.b)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if similarity := result.(types.Float64).ValueFloat64(); math.Abs(similarity-test.expected) > 1e-9 {
+				t.Errorf("expected %g, got %g", test.expected, similarity)
+			}
+		})
+	}
+
+	for name, args := range map[string][2]types.List{
+		"mismatched dimensions": {vector(1, 2, 3), vector(1, 2)},
+		"zero vector":           {vector(0, 0), vector(1, 2)},
+		"empty vectors":         {vector(), vector()},
+	} {
+		if _, diags := runFunction(t, NewCosineSimilarityFunction(), args[0], args[1]); !diags.HasError() {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &countTokensFunction{}
+)
+
+// NewCountTokensFunction is a helper function to simplify the provider implementation.
+func NewCountTokensFunction() function.Function {
+	return &countTokensFunction{}
+}
+
+// countTokensFunction is the function implementation.
+type countTokensFunction struct{}
+
+// Metadata returns the function name.
+func (f *countTokensFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "count_tokens"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *countTokensFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Counts the tokens of a text",
+		MarkdownDescription: "Returns the number of tokens of a text, tokenized with the encoding of the model, such as `o200k_base` for `gpt-4o` or `cl100k_base` for `gpt-4`. Use it in preconditions to check the size of instructions and prompts before they are sent to OpenAI.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "model",
+				MarkdownDescription: "Model the text is sent to, such as `gpt-4o`, `o3` or `text-embedding-3-small`. Fine-tuned models use the tokenizer of their base model.",
+			},
+			function.StringParameter{
+				Name:        "text",
+				Description: "Text to count the tokens of.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run counts the tokens of the text.
+func (f *countTokensFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var model, text string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &model, &text)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	count, err := countTokens(model, text)
+	if err != nil {
+		resp.Diagnostics.AddArgumentError(0, "Unsupported model", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, int64(count))...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// runFunction calls the function with the arguments, like Terraform does, and
+// returns its result.
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	var definition function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+	if definition.Diagnostics.HasError() {
+		t.Fatalf("unexpected definition error: %v", definition.Diagnostics)
+	}
+
+	returnType := definition.Definition.Return.GetType()
+	null, err := returnType.ValueFromTerraform(ctx, tftypes.NewValue(returnType.TerraformType(ctx), nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := function.RunResponse{Result: function.NewResultData(null)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+
+	return resp.Result.Value(), resp.Diagnostics
+}
+
+func TestCountTokensFunction(t *testing.T) {
+	tests := map[string]struct {
+		model    string
+		text     string
+		expected int64
+	}{
+		"o200k_base": {
+			model:    "gpt-4o",
+			text:     "Hello, world!",
+			expected: 4,
+		},
+		"cl100k_base": {
+			model:    "gpt-4",
+			text:     "tiktoken is great!",
+			expected: 6,
+		},
+		"snapshot": {
+			model:    "gpt-4.1-mini-2025-04-14",
+			text:     "Hello, world!",
+			expected: 4,
+		},
+		"fine-tuned model": {
+			model:    "ft:gpt-3.5-turbo-0125:my-org::abc123",
+			text:     "tiktoken is great!",
+			expected: 6,
+		},
+		"special tokens": {
+			model:    "gpt-4o",
+			text:     "<|endoftext|>",
+			expected: 7,
+		},
+		"empty text": {
+			model:    "o3",
+			expected: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := runFunction(t, NewCountTokensFunction(), types.StringValue(test.model), types.StringValue(test.text))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !result.Equal(types.Int64Value(test.expected)) {
+				t.Errorf("expected %d tokens, got %s", test.expected, result)
+			}
+		})
+	}
+
+	if _, diags := runFunction(t, NewCountTokensFunction(), types.StringValue("whisper-1"), types.StringValue("Hello")); !diags.HasError() {
+		t.Error("expected an error for a model without a tokenizer")
+	}
+}
+
+func TestAccCountTokensFunction(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Terraform calls the provider functions from 1.8.0.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "tokens" {
+  value = provider::openai::count_tokens("gpt-4o", "Hello, world!")
+}
+`,
+				Check: resource.TestCheckOutput("tokens", "4"),
+			},
+			{
+				Config: `
+output "tokens" {
+  value = provider::openai::count_tokens("whisper-1", "Hello, world!")
+}
+`,
+				ExpectError: regexp.MustCompile(`Unsupported model`),
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// createAttempts is the number of times a create request is sent when it
+// fails without a response from OpenAI, e.g. on a timeout or a reset
+// connection.
+const createAttempts = 3
+
+// createMarkerKey is the metadata key of the marker set on the objects created
+// by the provider, used to find them when the response of the create request
+// is lost.
+const createMarkerKey = "terraform_create_marker"
+
+// newCreateMarker returns a random marker, unique to a create operation.
+func newCreateMarker() (string, error) {
+	marker := make([]byte, 16)
+	if _, err := rand.Read(marker); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(marker), nil
+}
+
+// createOnce sends a create request, retrying it when it fails without a
+// response. Such a request may have succeeded anyway, so find is called before
+// each retry, and after the last attempt, to return the object created by a
+// previous attempt instead of creating a duplicate.
+func createOnce[T any](ctx context.Context, create func(context.Context) (T, error), find func(context.Context) (T, bool, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		object, err := create(ctx)
+		if err == nil || errorStatusCode(err) != 0 {
+			return object, err
+		}
+
+		// ctx may be done after a timeout, look for the object with a
+		// context of its own.
+		findCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), readAfterWriteTimeout)
+		found, ok, findErr := find(findCtx)
+		cancel()
+		if findErr == nil && ok {
+			return found, nil
+		}
+
+		if attempt == createAttempts || ctx.Err() != nil {
+			return object, err
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"testing"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+func TestCreateOnce(t *testing.T) {
+	errLost := errors.New("connection reset by peer")
+	errAPI := &openai.APIError{HTTPStatusCode: http.StatusBadRequest, Message: "Invalid request."}
+
+	tests := map[string]struct {
+		createErrors    []error
+		foundAfter      int
+		expected        string
+		expectedErr     error
+		expectedCreates int
+		expectedFinds   int
+	}{
+		"created": {
+			expected:        "created",
+			expectedCreates: 1,
+		},
+		"api error": {
+			createErrors:    []error{errAPI},
+			expectedErr:     errAPI,
+			expectedCreates: 1,
+		},
+		"retried": {
+			createErrors:    []error{errLost},
+			expected:        "created",
+			expectedCreates: 2,
+			expectedFinds:   1,
+		},
+		"created by a lost request": {
+			createErrors:    []error{errLost, errLost},
+			foundAfter:      2,
+			expected:        "found",
+			expectedCreates: 2,
+			expectedFinds:   2,
+		},
+		"attempts exhausted": {
+			createErrors:    []error{errLost, errLost, errLost},
+			expectedErr:     errLost,
+			expectedCreates: createAttempts,
+			expectedFinds:   createAttempts,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			creates, finds := 0, 0
+			object, err := createOnce(context.Background(), func(context.Context) (string, error) {
+				creates++
+				if creates <= len(test.createErrors) {
+					return "", test.createErrors[creates-1]
+				}
+				return "created", nil
+			}, func(context.Context) (string, bool, error) {
+				finds++
+				if test.foundAfter > 0 && finds >= test.foundAfter {
+					return "found", true, nil
+				}
+				return "", false, nil
+			})
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Errorf("expected error %v, got %v", test.expectedErr, err)
+			}
+			if object != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, object)
+			}
+			if creates != test.expectedCreates || finds != test.expectedFinds {
+				t.Errorf("expected %d creates and %d finds, got %d and %d", test.expectedCreates, test.expectedFinds, creates, finds)
+			}
+		})
+	}
+}
+
+func TestNewCreateMarker(t *testing.T) {
+	marker, err := newCreateMarker()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(`^[0-9a-f]{32}$`).MatchString(marker) {
+		t.Errorf("expected 32 hexadecimal characters, got %q", marker)
+	}
+
+	other, err := newCreateMarker()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if marker == other {
+		t.Errorf("expected unique markers, got %q twice", marker)
+	}
+}
+
+func TestFindAssistant(t *testing.T) {
+	server := newMockOpenAI(t)
+	client := server.client()
+	ctx := context.Background()
+
+	var created assistantObject
+	for _, marker := range []string{"marker1", "marker2"} {
+		name := testAccNamePrefix + marker
+		request := assistantRequest{
+			Name:     &name,
+			Model:    "gpt-4o",
+			Metadata: map[string]string{createMarkerKey: marker},
+		}
+		if err := client.doJSON(ctx, http.MethodPost, "/assistants", request, &created); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assistant, ok, err := client.findAssistant(ctx, "marker2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || assistant.ID != created.ID {
+		t.Errorf("expected to find %s, got %q (found: %t)", created.ID, assistant.ID, ok)
+	}
+	if metadata := assistant.userMetadata(); len(metadata) != 0 {
+		t.Errorf("expected the marker not to be part of the user metadata, got %v", metadata)
+	}
+
+	if _, ok, err := client.findAssistant(ctx, "unknown"); err != nil || ok {
+		t.Errorf("expected no assistant with an unknown marker, got found: %t, error: %v", ok, err)
+	}
+}
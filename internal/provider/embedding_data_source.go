@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &embeddingDataSource{}
+	_ datasource.DataSourceWithConfigure = &embeddingDataSource{}
+)
+
+// maxEmbeddingBatchSize is the maximum number of inputs accepted by a single
+// embeddings request.
+const maxEmbeddingBatchSize = 2048
+
+// embeddingVectorsType is the type of the embeddings attribute, a list of vectors.
+var embeddingVectorsType = types.ListType{ElemType: types.Float64Type}
+
+// embeddingRequest is the body of an embeddings request.
+type embeddingRequest struct {
+	Input      []string `json:"input"`
+	Model      string   `json:"model"`
+	Dimensions *int64   `json:"dimensions,omitempty"`
+}
+
+// embeddingResponse is the body of an embeddings response. The vectors are
+// decoded as float64 rather than the float32 used by the SDK, so the values
+// in state match the ones returned by the API.
+type embeddingResponse struct {
+	Data []struct {
+		Embedding []float64 `json:"embedding"`
+		Index     int       `json:"index"`
+	} `json:"data"`
+}
+
+// NewEmbeddingDataSource is a helper function to simplify the provider implementation.
+func NewEmbeddingDataSource() datasource.DataSource {
+	return &embeddingDataSource{}
+}
+
+// embeddingDataSource is the data source implementation.
+type embeddingDataSource struct {
+	client *openaiClient
+}
+
+// embeddingDataSourceModel maps the data source schema data.
+type embeddingDataSourceModel struct {
+	Model      types.String `tfsdk:"model"`
+	Input      types.List   `tfsdk:"input"`
+	Dimensions types.Int64  `tfsdk:"dimensions"`
+	BatchSize  types.Int64  `tfsdk:"batch_size"`
+	Embeddings types.List   `tfsdk:"embeddings"`
+}
+
+// Metadata returns the data source type name.
+func (d *embeddingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embedding"
+}
+
+// Schema defines the schema for the data source.
+func (d *embeddingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the embeddings of one or more inputs.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Model used to compute the embeddings, such as `text-embedding-3-small`, `text-embedding-3-large` or `text-embedding-ada-002`.",
+				Required:            true,
+			},
+			"input": schema.ListAttribute{
+				Description: "Texts to compute the embeddings of.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"dimensions": schema.Int64Attribute{
+				MarkdownDescription: "Number of dimensions of the resulting embeddings. Only supported by `text-embedding-3` and later models.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of inputs sent in a single API request. Larger inputs are split in several requests. Defaults to `%d`, the maximum supported by OpenAI.", maxEmbeddingBatchSize),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxEmbeddingBatchSize),
+				},
+			},
+			"embeddings": schema.ListAttribute{
+				MarkdownDescription: "Embedding vectors, in the same order as `input`.",
+				ElementType:         embeddingVectorsType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *embeddingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *embeddingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data embeddingDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addModelDeprecationWarning(&resp.Diagnostics, path.Root("model"), data.Model.ValueString(), time.Now())
+
+	var input []string
+	diags = data.Input.ElementsAs(ctx, &input, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	batchSize := maxEmbeddingBatchSize
+	if !data.BatchSize.IsNull() {
+		batchSize = int(data.BatchSize.ValueInt64())
+	}
+
+	vectors, err := d.client.createEmbeddings(ctx, data.Model.ValueString(), data.Dimensions.ValueInt64Pointer(), input, batchSize)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Unable to compute OpenAI embeddings", "", err)
+		return
+	}
+
+	data.Embeddings, diags = types.ListValueFrom(ctx, embeddingVectorsType, vectors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// createEmbeddings computes the embeddings of the input, sending at most
+// batchSize inputs per request. The vectors are returned in the same order as
+// the input, whatever the order of the API responses.
+func (c *openaiClient) createEmbeddings(ctx context.Context, model string, dimensions *int64, input []string, batchSize int) ([][]float64, error) {
+	vectors := make([][]float64, len(input))
+
+	for start := 0; start < len(input); start += batchSize {
+		end := min(start+batchSize, len(input))
+
+		var embeddings embeddingResponse
+		err := c.doJSON(ctx, http.MethodPost, "/embeddings", embeddingRequest{
+			Input:      input[start:end],
+			Model:      model,
+			Dimensions: dimensions,
+		}, &embeddings)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(embeddings.Data) != end-start {
+			return nil, fmt.Errorf("expected %d embeddings, got %d", end-start, len(embeddings.Data))
+		}
+
+		for _, embedding := range embeddings.Data {
+			if embedding.Index < 0 || embedding.Index >= end-start {
+				return nil, fmt.Errorf("unexpected embedding index %d", embedding.Index)
+			}
+			vectors[start+embedding.Index] = embedding.Embedding
+		}
+	}
+
+	return vectors, nil
+}
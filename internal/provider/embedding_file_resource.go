@@ -0,0 +1,515 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &embeddingFileResource{}
+	_ resource.ResourceWithConfigure  = &embeddingFileResource{}
+	_ resource.ResourceWithModifyPlan = &embeddingFileResource{}
+)
+
+// embeddingFileTimeout is the default create timeout of an embedding file,
+// long enough for large inputs throttled by requests_per_minute.
+const embeddingFileTimeout = time.Hour
+
+// NewEmbeddingFileResource is a helper function to simplify the provider implementation.
+func NewEmbeddingFileResource() resource.Resource {
+	return &embeddingFileResource{}
+}
+
+// embeddingFileResource is the resource implementation.
+type embeddingFileResource struct {
+	client *openaiClient
+}
+
+// embeddingFileResourceModel maps the resource schema data.
+type embeddingFileResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	InputPath         types.String   `tfsdk:"input_path"`
+	InputFormat       types.String   `tfsdk:"input_format"`
+	TextField         types.String   `tfsdk:"text_field"`
+	IDField           types.String   `tfsdk:"id_field"`
+	OutputPath        types.String   `tfsdk:"output_path"`
+	Model             types.String   `tfsdk:"model"`
+	Dimensions        types.Int64    `tfsdk:"dimensions"`
+	BatchSize         types.Int64    `tfsdk:"batch_size"`
+	RequestsPerMinute types.Int64    `tfsdk:"requests_per_minute"`
+	InputSHA256       types.String   `tfsdk:"input_sha256"`
+	EmbeddingCount    types.Int64    `tfsdk:"embedding_count"`
+	Project           types.String   `tfsdk:"project"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// embeddingFileRecord is a text read from the input file.
+type embeddingFileRecord struct {
+	ID   any
+	Text string
+}
+
+// embeddingFileLine is a line written to the output file.
+type embeddingFileLine struct {
+	Index     int       `json:"index"`
+	ID        any       `json:"id,omitempty"`
+	Embedding []float64 `json:"embedding"`
+}
+
+// Metadata returns the resource type name.
+func (r *embeddingFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embedding_file"
+}
+
+// Schema defines the schema for the resource.
+func (r *embeddingFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Computes the embeddings of the texts of a local JSONL or CSV file and writes them to a JSONL output file, one `{\"index\", \"id\", \"embedding\"}` object per line. The embeddings are only computed again when the content of the input file, or one of the arguments, changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "SHA-256 checksum of the input file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"input_path": schema.StringAttribute{
+				Description: "Path to the JSONL or CSV file containing the texts.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input_format": schema.StringAttribute{
+				MarkdownDescription: "Format of the input file, either `jsonl` or `csv`. Defaults to the extension of `input_path`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("jsonl", "csv"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"text_field": schema.StringAttribute{
+				MarkdownDescription: "JSON field, or CSV column, containing the text. Defaults to `text`. JSONL lines may also be plain JSON strings.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id_field": schema.StringAttribute{
+				MarkdownDescription: "JSON field, or CSV column, copied to the `id` of each output line.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				Description: "Path to the JSONL file the embeddings are written to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Model used to compute the embeddings, such as `text-embedding-3-small`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dimensions": schema.Int64Attribute{
+				MarkdownDescription: "Number of dimensions of the resulting embeddings. Only supported by `text-embedding-3` and later models.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of texts sent in a single API request. Defaults to `%d`.", maxEmbeddingBatchSize),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxEmbeddingBatchSize),
+				},
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "Maximum number of embeddings requests sent per minute. Unlimited when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"input_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the input file. A change of the checksum computes the embeddings again.",
+				Computed:    true,
+			},
+			"embedding_count": schema.Int64Attribute{
+				Description: "Number of embeddings written to the output file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the embeddings are billed to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *embeddingFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the checksum of the input file, so a change of its
+// content computes the embeddings again, and checks the model is available
+// to the API key.
+func (r *embeddingFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.modifyPlanModel(ctx, req, resp)
+
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan embeddingFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.InputPath.IsUnknown() {
+		return
+	}
+
+	modifyPlanLocalFilesChecksum(ctx, req, resp, path.Root("input_sha256"), plan.InputPath.ValueString())
+}
+
+// Create a new resource.
+func (r *embeddingFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan embeddingFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, embeddingFileTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	content, err := os.ReadFile(plan.InputPath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading input file",
+			"Could not create embedding file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	format := plan.InputFormat.ValueString()
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(plan.InputPath.ValueString())), ".")
+	}
+
+	textField := "text"
+	if !plan.TextField.IsNull() {
+		textField = plan.TextField.ValueString()
+	}
+
+	records, err := readEmbeddingFileRecords(content, format, textField, plan.IDField.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("input_path"),
+			"Error parsing input file",
+			"Could not create embedding file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	batchSize := maxEmbeddingBatchSize
+	if !plan.BatchSize.IsNull() {
+		batchSize = int(plan.BatchSize.ValueInt64())
+	}
+
+	var interval time.Duration
+	if !plan.RequestsPerMinute.IsNull() {
+		interval = time.Minute / time.Duration(plan.RequestsPerMinute.ValueInt64())
+	}
+
+	var output bytes.Buffer
+	encoder := json.NewEncoder(&output)
+
+	for start := 0; start < len(records); start += batchSize {
+		end := min(start+batchSize, len(records))
+
+		if start > 0 && interval > 0 {
+			select {
+			case <-ctx.Done():
+				resp.Diagnostics.AddError(
+					"Error computing embeddings",
+					"Could not create embedding file, unexpected error: "+ctx.Err().Error(),
+				)
+				return
+			case <-time.After(interval):
+			}
+		}
+
+		input := make([]string, end-start)
+		for i, record := range records[start:end] {
+			input[i] = record.Text
+		}
+
+		vectors, err := r.client.createEmbeddings(ctx, plan.Model.ValueString(), plan.Dimensions.ValueInt64Pointer(), input, batchSize)
+		if err != nil {
+			addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error computing embeddings", "Could not create embedding file, unexpected error: ", err)
+			return
+		}
+
+		for i, vector := range vectors {
+			err = encoder.Encode(embeddingFileLine{
+				Index:     start + i,
+				ID:        records[start+i].ID,
+				Embedding: vector,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error encoding embeddings",
+					"Could not create embedding file, unexpected error: "+err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	if err := writeLocalFile(plan.OutputPath.ValueString(), output.Bytes()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error writing output file",
+			"Could not create embedding file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	checksum := sha256Hex(content)
+	plan.ID = types.StringValue(checksum)
+	plan.InputSHA256 = types.StringValue(checksum)
+	plan.EmbeddingCount = types.Int64Value(int64(len(records)))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *embeddingFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state embeddingFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compute the embeddings again when the output file has been removed.
+	if !localFileExists(state.OutputPath.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *embeddingFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only batch_size and requests_per_minute can change in place, they do
+	// not affect the embeddings already written.
+	var plan embeddingFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *embeddingFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state embeddingFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := removeLocalFile(state.OutputPath.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting output file",
+			"Could not delete embedding file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// readEmbeddingFileRecords parses the texts of a JSONL or CSV input file.
+func readEmbeddingFileRecords(content []byte, format, textField, idField string) ([]embeddingFileRecord, error) {
+	switch format {
+	case "jsonl":
+		return readEmbeddingFileJSONL(content, textField, idField)
+	case "csv":
+		return readEmbeddingFileCSV(content, textField, idField)
+	default:
+		return nil, fmt.Errorf("unsupported input format %q, set input_format to jsonl or csv", format)
+	}
+}
+
+// readEmbeddingFileJSONL parses a JSONL input file, whose lines are either
+// JSON strings, or JSON objects holding the text in textField and the optional
+// ID in idField. The IDs are kept as decoded, e.g. as numbers. Blank lines are
+// skipped.
+func readEmbeddingFileJSONL(content []byte, textField, idField string) ([]embeddingFileRecord, error) {
+	var records []embeddingFileRecord
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(content)+1)
+
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		var value any
+		if err := json.Unmarshal(scanner.Bytes(), &value); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		switch value := value.(type) {
+		case string:
+			records = append(records, embeddingFileRecord{Text: value})
+		case map[string]any:
+			text, ok := value[textField].(string)
+			if !ok {
+				return nil, fmt.Errorf("line %d: field %q is missing or is not a string", line, textField)
+			}
+			record := embeddingFileRecord{Text: text}
+			if idField != "" {
+				record.ID = value[idField]
+			}
+			records = append(records, record)
+		default:
+			return nil, fmt.Errorf("line %d: expected a JSON object or string", line)
+		}
+	}
+
+	return records, scanner.Err()
+}
+
+// readEmbeddingFileCSV parses a CSV input file with a header row, holding the
+// text in the textField column and the optional ID in the idField column. The
+// same column can hold both.
+func readEmbeddingFileCSV(content []byte, textField, idField string) ([]embeddingFileRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	textColumn, idColumn := -1, -1
+	for i, name := range header {
+		if name == textField {
+			textColumn = i
+		}
+		if idField != "" && name == idField {
+			idColumn = i
+		}
+	}
+
+	if textColumn < 0 {
+		return nil, fmt.Errorf("column %q not found in header", textField)
+	}
+
+	if idField != "" && idColumn < 0 {
+		return nil, fmt.Errorf("column %q not found in header", idField)
+	}
+
+	var records []embeddingFileRecord
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		record := embeddingFileRecord{Text: row[textColumn]}
+		if idColumn >= 0 {
+			record.ID = row[idColumn]
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
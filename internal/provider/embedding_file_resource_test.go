@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadEmbeddingFileRecords(t *testing.T) {
+	tests := map[string]struct {
+		content   string
+		format    string
+		textField string
+		idField   string
+		expected  []embeddingFileRecord
+		err       bool
+	}{
+		"jsonl strings": {
+			content:   "\"first\"\n\n\"second\"\n",
+			format:    "jsonl",
+			textField: "text",
+			expected:  []embeddingFileRecord{{Text: "first"}, {Text: "second"}},
+		},
+		"jsonl objects": {
+			content:   `{"text":"first","id":1}` + "\n" + `{"text":"second","id":"two"}`,
+			format:    "jsonl",
+			textField: "text",
+			idField:   "id",
+			expected:  []embeddingFileRecord{{ID: float64(1), Text: "first"}, {ID: "two", Text: "second"}},
+		},
+		"jsonl objects without ID field": {
+			content:   `{"text":"first","id":1}`,
+			format:    "jsonl",
+			textField: "text",
+			expected:  []embeddingFileRecord{{Text: "first"}},
+		},
+		"jsonl missing text": {
+			content:   `{"body":"first"}`,
+			format:    "jsonl",
+			textField: "text",
+			err:       true,
+		},
+		"jsonl invalid": {
+			content:   `{"text":`,
+			format:    "jsonl",
+			textField: "text",
+			err:       true,
+		},
+		"jsonl number": {
+			content:   `42`,
+			format:    "jsonl",
+			textField: "text",
+			err:       true,
+		},
+		"csv": {
+			content:   "id,text\n1,first\n2,\"second, quoted\"\n",
+			format:    "csv",
+			textField: "text",
+			idField:   "id",
+			expected:  []embeddingFileRecord{{ID: "1", Text: "first"}, {ID: "2", Text: "second, quoted"}},
+		},
+		"csv without ID field": {
+			content:   "id,text\n1,first\n",
+			format:    "csv",
+			textField: "text",
+			expected:  []embeddingFileRecord{{Text: "first"}},
+		},
+		"csv text and ID in the same column": {
+			content:   "text,other\nfirst,x\n",
+			format:    "csv",
+			textField: "text",
+			idField:   "text",
+			expected:  []embeddingFileRecord{{ID: "first", Text: "first"}},
+		},
+		"csv missing text column": {
+			content:   "id,body\n1,first\n",
+			format:    "csv",
+			textField: "text",
+			err:       true,
+		},
+		"csv missing ID column": {
+			content:   "text\nfirst\n",
+			format:    "csv",
+			textField: "text",
+			idField:   "id",
+			err:       true,
+		},
+		"csv empty": {
+			format:    "csv",
+			textField: "text",
+			err:       true,
+		},
+		"unsupported format": {
+			content:   "first",
+			format:    "txt",
+			textField: "text",
+			err:       true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			records, err := readEmbeddingFileRecords([]byte(test.content), test.format, test.textField, test.idField)
+			if test.err {
+				if err == nil {
+					t.Errorf("expected an error, got %v", records)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(records, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, records)
+			}
+		})
+	}
+}
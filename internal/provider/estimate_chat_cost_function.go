@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &estimateChatCostFunction{}
+)
+
+// chatMessageType is the type of the messages of openai_chat_completion.
+var chatMessageType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"role":    types.StringType,
+		"content": types.StringType,
+	},
+}
+
+// chatInputPrices are the prices of the input tokens of the chat models, in
+// USD per million tokens, see https://openai.com/api/pricing. The snapshots
+// of a model share its price.
+var chatInputPrices = map[string]float64{
+	"gpt-5":         1.25,
+	"gpt-5-mini":    0.25,
+	"gpt-5-nano":    0.05,
+	"gpt-4.1":       2,
+	"gpt-4.1-mini":  0.4,
+	"gpt-4.1-nano":  0.1,
+	"gpt-4o":        2.5,
+	"gpt-4o-mini":   0.15,
+	"o1":            15,
+	"o3":            2,
+	"o3-mini":       1.1,
+	"o4-mini":       1.1,
+	"gpt-4-turbo":   10,
+	"gpt-4":         30,
+	"gpt-3.5-turbo": 0.5,
+}
+
+// Each message is wrapped in tokens of the chat format, and the reply is
+// primed with tokens of its own, see
+// https://cookbook.openai.com/examples/how_to_count_tokens_with_tiktoken.
+const (
+	chatTokensPerMessage = 3
+	chatTokensPerReply   = 3
+)
+
+// NewEstimateChatCostFunction is a helper function to simplify the provider implementation.
+func NewEstimateChatCostFunction() function.Function {
+	return &estimateChatCostFunction{}
+}
+
+// estimateChatCostFunction is the function implementation.
+type estimateChatCostFunction struct{}
+
+// Metadata returns the function name.
+func (f *estimateChatCostFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "estimate_chat_cost"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *estimateChatCostFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Estimates the input cost of chat messages",
+		MarkdownDescription: "Estimates the cost, in USD, of the input tokens of the messages of a chat completion, such as the `messages` of `openai_chat_completion`. The price of the model is taken from the prices passed to the function, then from the prices of the provider for the `gpt-5`, `gpt-4.1`, `gpt-4o`, `o1`, `o3`, `o4-mini`, `gpt-4` and `gpt-3.5-turbo` models and their snapshots. The output tokens and the cached input tokens are not included.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "model",
+				MarkdownDescription: "Model the messages are sent to, such as `gpt-4o`.",
+			},
+			function.ListParameter{
+				Name:                "messages",
+				ElementType:         chatMessageType,
+				MarkdownDescription: "Messages, objects with a `role` and a `content`.",
+			},
+		},
+		VariadicParameter: function.MapParameter{
+			Name:                "prices",
+			ElementType:         types.Float64Type,
+			MarkdownDescription: "Prices of the input tokens by model, in USD per million tokens, overriding the prices of the provider, e.g. `{ \"ft:gpt-4o-mini-2024-07-18:my-org::abc123\" = 0.3 }`.",
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+// Run estimates the cost of the messages.
+func (f *estimateChatCostFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var model string
+	var messages []chatCompletionMessageModel
+	var prices []map[string]float64
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &model, &messages, &prices)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	price, ok := chatInputPrice(model, prices)
+	if !ok {
+		resp.Diagnostics.AddArgumentError(0, "Unknown model price", fmt.Sprintf("The price of the model %q is unknown, pass it in the prices argument, in USD per million input tokens.", model))
+		return
+	}
+
+	tokens, err := countChatTokens(model, messages)
+	if err != nil {
+		resp.Diagnostics.AddArgumentError(0, "Unsupported model", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, float64(tokens)*price/1e6)...)
+}
+
+// chatInputPrice returns the price of the input tokens of the model, in USD
+// per million tokens, from the prices overriding the table of the provider.
+func chatInputPrice(model string, overrides []map[string]float64) (float64, bool) {
+	for i := len(overrides) - 1; i >= 0; i-- {
+		if price, ok := overrides[i][model]; ok {
+			return price, true
+		}
+	}
+
+	if price, ok := chatInputPrices[model]; ok {
+		return price, true
+	}
+	for name, price := range chatInputPrices {
+		if snapshot, ok := strings.CutPrefix(model, name); ok && modelSnapshotPattern.MatchString(snapshot) {
+			return price, true
+		}
+	}
+
+	return 0, false
+}
+
+// countChatTokens returns the number of input tokens of the messages sent to
+// the model.
+func countChatTokens(model string, messages []chatCompletionMessageModel) (int, error) {
+	tokens := chatTokensPerReply
+	for _, message := range messages {
+		for _, text := range []string{message.Role.ValueString(), message.Content.ValueString()} {
+			count, err := countTokens(model, text)
+			if err != nil {
+				return 0, err
+			}
+			tokens += count
+		}
+		tokens += chatTokensPerMessage
+	}
+
+	return tokens, nil
+}
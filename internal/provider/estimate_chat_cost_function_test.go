@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEstimateChatCostFunction(t *testing.T) {
+	// Each message is 3 tokens of the chat format, 1 of the role and 4 of the
+	// content, plus 3 tokens priming the reply: 3 + 2 * 8 = 19 tokens.
+	messages := types.ListValueMust(chatMessageType, []attr.Value{
+		types.ObjectValueMust(chatMessageType.AttrTypes, map[string]attr.Value{
+			"role":    types.StringValue("system"),
+			"content": types.StringValue("Hello, world!"),
+		}),
+		types.ObjectValueMust(chatMessageType.AttrTypes, map[string]attr.Value{
+			"role":    types.StringValue("user"),
+			"content": types.StringValue("Hello, world!"),
+		}),
+	})
+	noPrices := types.ListValueMust(types.MapType{ElemType: types.Float64Type}, nil)
+	prices := types.ListValueMust(types.MapType{ElemType: types.Float64Type}, []attr.Value{
+		types.MapValueMust(types.Float64Type, map[string]attr.Value{
+			"ft:gpt-4o-mini-2024-07-18:my-org::abc123": types.Float64Value(0.3),
+			"gpt-4o": types.Float64Value(5),
+		}),
+	})
+
+	tests := map[string]struct {
+		model    string
+		prices   types.List
+		expected float64
+	}{
+		"model": {
+			model:    "gpt-4o",
+			prices:   noPrices,
+			expected: 19 * 2.5 / 1e6,
+		},
+		"snapshot": {
+			model:    "gpt-4o-2024-08-06",
+			prices:   noPrices,
+			expected: 19 * 2.5 / 1e6,
+		},
+		"overridden price": {
+			model:    "gpt-4o",
+			prices:   prices,
+			expected: 19 * 5 / 1e6,
+		},
+		"fine-tuned model": {
+			model:    "ft:gpt-4o-mini-2024-07-18:my-org::abc123",
+			prices:   prices,
+			expected: 19 * 0.3 / 1e6,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := runFunction(t, NewEstimateChatCostFunction(), types.StringValue(test.model), messages, test.prices)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if cost := result.(types.Float64).ValueFloat64(); math.Abs(cost-test.expected) > 1e-12 {
+				t.Errorf("expected a cost of %g, got %g", test.expected, cost)
+			}
+		})
+	}
+
+	if _, diags := runFunction(t, NewEstimateChatCostFunction(), types.StringValue("ft:gpt-4o-mini-2024-07-18:my-org::abc123"), messages, noPrices); !diags.HasError() {
+		t.Error("expected an error for a model without a price")
+	}
+}
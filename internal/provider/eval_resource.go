@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &evalResource{}
+	_ resource.ResourceWithConfigure      = &evalResource{}
+	_ resource.ResourceWithImportState    = &evalResource{}
+	_ resource.ResourceWithValidateConfig = &evalResource{}
+)
+
+// NewEvalResource is a helper function to simplify the provider implementation.
+func NewEvalResource() resource.Resource {
+	return &evalResource{}
+}
+
+// evalResource is the resource implementation.
+type evalResource struct {
+	client *openaiClient
+}
+
+// evalResourceModel maps the resource schema data.
+type evalResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	DataSourceConfig types.String   `tfsdk:"data_source_config"`
+	TestingCriteria  types.String   `tfsdk:"testing_criteria"`
+	Metadata         types.Map      `tfsdk:"metadata"`
+	CreatedAt        types.Int64    `tfsdk:"created_at"`
+	Project          types.String   `tfsdk:"project"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// evalRequest is the body of a request creating or updating an eval. The data
+// source configuration and the graders are passed through as is, they are
+// configured with jsonencode.
+type evalRequest struct {
+	Name             string            `json:"name,omitempty"`
+	DataSourceConfig json.RawMessage   `json:"data_source_config,omitempty"`
+	TestingCriteria  json.RawMessage   `json:"testing_criteria,omitempty"`
+	Metadata         map[string]string `json:"metadata"`
+}
+
+// evalObject is an eval returned by the Evals API.
+type evalObject struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	CreatedAt        int64             `json:"created_at"`
+	DataSourceConfig json.RawMessage   `json:"data_source_config"`
+	TestingCriteria  json.RawMessage   `json:"testing_criteria"`
+	Metadata         map[string]string `json:"metadata"`
+}
+
+// Metadata returns the resource type name.
+func (r *evalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eval"
+}
+
+// Schema defines the schema for the resource.
+func (r *evalResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides an OpenAI eval, the data source configuration and graders used to evaluate model outputs. Use `openai_eval_run` to run it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the eval.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the eval. Defaults to a name generated by OpenAI.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_source_config": schema.StringAttribute{
+				MarkdownDescription: "Configuration of the data source of the eval runs, JSON encoded with `jsonencode`, such as `{ type = \"custom\", item_schema = { ... } }`. See the [Evals API reference](https://platform.openai.com/docs/api-reference/evals/create).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"testing_criteria": schema.StringAttribute{
+				MarkdownDescription: "List of graders for all eval runs, JSON encoded with `jsonencode`, such as `[{ type = \"string_check\", ... }]`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the eval.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  metadataValidators(),
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the eval.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the eval, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *evalResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig validates that the data source configuration and the graders
+// are valid JSON of the expected shape.
+func (r *evalResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config evalResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.DataSourceConfig.IsUnknown() {
+		var dataSourceConfig struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal([]byte(config.DataSourceConfig.ValueString()), &dataSourceConfig); err != nil || dataSourceConfig.Type == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("data_source_config"),
+				"Invalid data source configuration",
+				"data_source_config must be a JSON object with a type, such as custom, logs or stored_completions.",
+			)
+		}
+	}
+
+	if !config.TestingCriteria.IsUnknown() {
+		var testingCriteria []struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal([]byte(config.TestingCriteria.ValueString()), &testingCriteria); err != nil || len(testingCriteria) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("testing_criteria"),
+				"Invalid testing criteria",
+				"testing_criteria must be a non-empty JSON array of graders.",
+			)
+		}
+	}
+}
+
+// Create a new resource.
+func (r *evalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan evalResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	evalRequest := evalRequest{
+		Name:             plan.Name.ValueString(),
+		DataSourceConfig: json.RawMessage(plan.DataSourceConfig.ValueString()),
+		TestingCriteria:  json.RawMessage(plan.TestingCriteria.ValueString()),
+	}
+
+	evalRequest.Metadata, diags = stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var eval evalObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/evals", evalRequest, &eval)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating eval", "Could not create eval, unexpected error: ", err)
+		return
+	}
+
+	// Wait for the eval to be readable, the resources depending on it
+	// would otherwise fail with a 404 Not Found.
+	if err := r.client.waitReadable(ctx, "/evals/"+eval.ID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Eval not readable yet",
+			"The eval "+eval.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(eval.ID)
+	plan.Name = types.StringValue(eval.Name)
+	plan.CreatedAt = types.Int64Value(eval.CreatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *evalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state evalResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed eval value from OpenAI
+	var eval evalObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/evals/"+state.ID.ValueString(), nil, &eval)
+	if removeNotFound(ctx, resp, err, "OpenAI eval "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI eval", "Could not read OpenAI eval ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	state.CreatedAt = types.Int64Value(eval.CreatedAt)
+	state.Name = types.StringValue(eval.Name)
+
+	// The API adds defaults to the configuration and the graders, the
+	// configured JSON is only set on import to avoid perpetual diffs.
+	if state.DataSourceConfig.IsNull() {
+		state.DataSourceConfig = types.StringValue(string(eval.DataSourceConfig))
+	}
+	if state.TestingCriteria.IsNull() {
+		state.TestingCriteria = types.StringValue(string(eval.TestingCriteria))
+	}
+
+	state.Metadata, diags = stringMapFromAPI(ctx, state.Metadata, eval.Metadata)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *evalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan evalResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	// Only the name and the metadata can be updated, any other change replaces
+	// the eval.
+	evalRequest := evalRequest{
+		Name: plan.Name.ValueString(),
+	}
+
+	evalRequest.Metadata, diags = stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.doJSON(ctx, http.MethodPost, "/evals/"+plan.ID.ValueString(), evalRequest, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating OpenAI eval", "Could not update eval, unexpected error: ", err)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *evalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state evalResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing eval
+	err := r.client.doJSON(ctx, http.MethodDelete, "/evals/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI eval", "Could not delete eval, unexpected error: ", err)
+		return
+	}
+}
+
+func (r *evalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,503 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &evalRunResource{}
+	_ resource.ResourceWithConfigure      = &evalRunResource{}
+	_ resource.ResourceWithValidateConfig = &evalRunResource{}
+)
+
+// evalRunPollInterval is the interval between two checks of the status of an
+// eval run. Eval runs take minutes, there is no point in polling faster.
+const evalRunPollInterval = 10 * time.Second
+
+// evalRunTimeout is the default create timeout of an eval run, long enough for
+// the runs waited for to complete.
+const evalRunTimeout = 2 * time.Hour
+
+// NewEvalRunResource is a helper function to simplify the provider implementation.
+func NewEvalRunResource() resource.Resource {
+	return &evalRunResource{}
+}
+
+// evalRunResource is the resource implementation.
+type evalRunResource struct {
+	client *openaiClient
+}
+
+// evalRunResourceModel maps the resource schema data.
+type evalRunResourceModel struct {
+	ID                types.String                  `tfsdk:"id"`
+	EvalID            types.String                  `tfsdk:"eval_id"`
+	Name              types.String                  `tfsdk:"name"`
+	DataSource        types.String                  `tfsdk:"data_source"`
+	Metadata          types.Map                     `tfsdk:"metadata"`
+	WaitForCompletion types.Bool                    `tfsdk:"wait_for_completion"`
+	Status            types.String                  `tfsdk:"status"`
+	ReportURL         types.String                  `tfsdk:"report_url"`
+	ResultCounts      types.Object                  `tfsdk:"result_counts"`
+	PassRate          types.Float64                 `tfsdk:"pass_rate"`
+	TestingCriteria   []evalRunCriteriaResultsModel `tfsdk:"per_testing_criteria_results"`
+	Project           types.String                  `tfsdk:"project"`
+	Timeouts          timeouts.Value                `tfsdk:"timeouts"`
+}
+
+// evalRunCriteriaResultsModel maps the results of a grader.
+type evalRunCriteriaResultsModel struct {
+	TestingCriteria types.String `tfsdk:"testing_criteria"`
+	Passed          types.Int64  `tfsdk:"passed"`
+	Failed          types.Int64  `tfsdk:"failed"`
+}
+
+// evalRunResultCountsType is the type of the result_counts attribute.
+var evalRunResultCountsType = map[string]attr.Type{
+	"total":   types.Int64Type,
+	"passed":  types.Int64Type,
+	"failed":  types.Int64Type,
+	"errored": types.Int64Type,
+}
+
+// evalRunRequest is the body of a request creating an eval run. The data
+// source is passed through as is, it is configured with jsonencode.
+type evalRunRequest struct {
+	Name       string            `json:"name,omitempty"`
+	DataSource json.RawMessage   `json:"data_source"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+// evalRunObject is an eval run returned by the Evals API.
+type evalRunObject struct {
+	ID           string `json:"id"`
+	EvalID       string `json:"eval_id"`
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	ReportURL    string `json:"report_url"`
+	CreatedAt    int64  `json:"created_at"`
+	ResultCounts struct {
+		Total   int64 `json:"total"`
+		Passed  int64 `json:"passed"`
+		Failed  int64 `json:"failed"`
+		Errored int64 `json:"errored"`
+	} `json:"result_counts"`
+	PerTestingCriteriaResults []struct {
+		TestingCriteria string `json:"testing_criteria"`
+		Passed          int64  `json:"passed"`
+		Failed          int64  `json:"failed"`
+	} `json:"per_testing_criteria_results"`
+	Error *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Metadata returns the resource type name.
+func (r *evalRunResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eval_run"
+}
+
+// Schema defines the schema for the resource.
+func (r *evalRunResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs an eval against a model, such as a fine-tuned checkpoint, and exposes its results, e.g. to gate a deployment with a `postcondition` on `pass_rate`. The eval is only run again when one of the arguments changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the eval run.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"eval_id": schema.StringAttribute{
+				Description: "ID of the eval to run.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the eval run.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data_source": schema.StringAttribute{
+				MarkdownDescription: "Data source of the run, JSON encoded with `jsonencode`, including the model to evaluate, such as `{ type = \"completions\", model = \"ft:gpt-4o-mini:...\", source = { ... }, input_messages = { ... } }`. See the [Evals API reference](https://platform.openai.com/docs/api-reference/evals/createRun).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the eval run.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  metadataValidators(),
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the run to complete before returning. When `false`, the results are refreshed on the next plans. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the eval run, such as `queued`, `in_progress`, `completed`, `failed` or `canceled`.",
+				Computed:            true,
+			},
+			"report_url": schema.StringAttribute{
+				Description: "URL of the report of the eval run in the OpenAI dashboard.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"result_counts": schema.SingleNestedAttribute{
+				Description: "Number of evaluated items, per result.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"total": schema.Int64Attribute{
+						Description: "Number of evaluated items.",
+						Computed:    true,
+					},
+					"passed": schema.Int64Attribute{
+						Description: "Number of items passing all graders.",
+						Computed:    true,
+					},
+					"failed": schema.Int64Attribute{
+						Description: "Number of items failing at least one grader.",
+						Computed:    true,
+					},
+					"errored": schema.Int64Attribute{
+						Description: "Number of items that could not be evaluated.",
+						Computed:    true,
+					},
+				},
+			},
+			"pass_rate": schema.Float64Attribute{
+				MarkdownDescription: "Ratio, between 0 and 1, of the evaluated items passing all graders. `0` until items are evaluated.",
+				Computed:            true,
+			},
+			"per_testing_criteria_results": schema.ListNestedAttribute{
+				Description: "Results of the eval run, per grader.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"testing_criteria": schema.StringAttribute{
+							Description: "Name of the grader.",
+							Computed:    true,
+						},
+						"passed": schema.Int64Attribute{
+							Description: "Number of items passing the grader.",
+							Computed:    true,
+						},
+						"failed": schema.Int64Attribute{
+							Description: "Number of items failing the grader.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the eval, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *evalRunResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig validates that the data source is valid JSON.
+func (r *evalRunResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config evalRunResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.DataSource.IsUnknown() {
+		return
+	}
+
+	var dataSource struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal([]byte(config.DataSource.ValueString()), &dataSource); err != nil || dataSource.Type == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data_source"),
+			"Invalid data source",
+			"data_source must be a JSON object with a type, such as completions, responses or jsonl.",
+		)
+	}
+}
+
+// Create a new resource.
+func (r *evalRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan evalRunResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, evalRunTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	runRequest := evalRunRequest{
+		Name:       plan.Name.ValueString(),
+		DataSource: json.RawMessage(plan.DataSource.ValueString()),
+	}
+
+	runRequest.Metadata, diags = stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var run evalRunObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/evals/"+plan.EvalID.ValueString()+"/runs", runRequest, &run)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating eval run", "Could not create eval run, unexpected error: ", err)
+		return
+	}
+
+	if plan.WaitForCompletion.ValueBool() {
+		run, err = r.client.waitEvalRun(ctx, run)
+		if err != nil {
+			// Keep track of the run in state, it can be inspected in the
+			// report and is deleted with the resource.
+			addAPIError(&resp.Diagnostics, "Error running eval", "Could not complete eval run "+run.ID+": ", err)
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	diags = plan.refresh(run)
+	resp.Diagnostics.Append(diags...)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *evalRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state evalRunResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed eval run value from OpenAI
+	var run evalRunObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/evals/"+state.EvalID.ValueString()+"/runs/"+state.ID.ValueString(), nil, &run)
+	if removeNotFound(ctx, resp, err, "OpenAI eval run "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI eval run", "Could not read OpenAI eval run ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	diags = state.refresh(run)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *evalRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only wait_for_completion and the timeouts can change without a
+	// replacement, there is nothing to send to OpenAI.
+	var plan, state evalRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.WaitForCompletion = plan.WaitForCompletion
+	state.Timeouts = plan.Timeouts
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *evalRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state evalRunResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing eval run
+	err := r.client.doJSON(ctx, http.MethodDelete, "/evals/"+state.EvalID.ValueString()+"/runs/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI eval run", "Could not delete eval run, unexpected error: ", err)
+		return
+	}
+}
+
+// waitEvalRun polls the eval run until it reaches a terminal status.
+func (c *openaiClient) waitEvalRun(ctx context.Context, run evalRunObject) (evalRunObject, error) {
+	ctx = withFreshReads(ctx)
+
+	runPath := "/evals/" + run.EvalID + "/runs/" + run.ID
+
+	for {
+		switch run.Status {
+		case "completed":
+			return run, nil
+		case "failed":
+			if run.Error != nil {
+				return run, fmt.Errorf("eval run failed with %s: %s", run.Error.Code, run.Error.Message)
+			}
+			return run, fmt.Errorf("eval run failed")
+		case "canceled":
+			return run, fmt.Errorf("eval run canceled")
+		}
+
+		select {
+		case <-ctx.Done():
+			return run, ctx.Err()
+		case <-time.After(evalRunPollInterval):
+		}
+
+		// The first polls can hit a replica not aware of the new run yet.
+		err := retryNotFound(ctx, func(ctx context.Context) error {
+			return c.doJSON(ctx, http.MethodGet, runPath, nil, &run)
+		})
+		if err != nil {
+			return run, err
+		}
+	}
+}
+
+// refresh populates the computed attributes from the eval run.
+func (m *evalRunResourceModel) refresh(run evalRunObject) diag.Diagnostics {
+	m.ID = types.StringValue(run.ID)
+	m.Status = types.StringValue(run.Status)
+	m.ReportURL = types.StringValue(run.ReportURL)
+
+	m.PassRate = types.Float64Value(run.passRate())
+
+	m.TestingCriteria = []evalRunCriteriaResultsModel{}
+	for _, result := range run.PerTestingCriteriaResults {
+		m.TestingCriteria = append(m.TestingCriteria, evalRunCriteriaResultsModel{
+			TestingCriteria: types.StringValue(result.TestingCriteria),
+			Passed:          types.Int64Value(result.Passed),
+			Failed:          types.Int64Value(result.Failed),
+		})
+	}
+
+	var diags diag.Diagnostics
+	m.ResultCounts, diags = run.resultCounts()
+
+	return diags
+}
+
+// passRate returns the ratio of the evaluated items passing all graders.
+func (r evalRunObject) passRate() float64 {
+	if r.ResultCounts.Total == 0 {
+		return 0
+	}
+	return float64(r.ResultCounts.Passed) / float64(r.ResultCounts.Total)
+}
+
+// resultCounts returns the result counts as a result_counts attribute value.
+func (r evalRunObject) resultCounts() (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(evalRunResultCountsType, map[string]attr.Value{
+		"total":   types.Int64Value(r.ResultCounts.Total),
+		"passed":  types.Int64Value(r.ResultCounts.Passed),
+		"failed":  types.Int64Value(r.ResultCounts.Failed),
+		"errored": types.Int64Value(r.ResultCounts.Errored),
+	})
+}
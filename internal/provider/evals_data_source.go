@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &evalsDataSource{}
+	_ datasource.DataSourceWithConfigure = &evalsDataSource{}
+)
+
+// evalsConcurrency is the number of eval runs requested at once.
+const evalsConcurrency = 8
+
+// NewEvalsDataSource is a helper function to simplify the provider implementation.
+func NewEvalsDataSource() datasource.DataSource {
+	return &evalsDataSource{}
+}
+
+// evalsDataSource is the data source implementation.
+type evalsDataSource struct {
+	client *openaiClient
+}
+
+// evalsDataSourceModel maps the data source schema data.
+type evalsDataSourceModel struct {
+	Limit    types.Int64      `tfsdk:"limit"`
+	PageSize types.Int64      `tfsdk:"page_size"`
+	Evals    []evalsItemModel `tfsdk:"evals"`
+}
+
+// evalsItemModel maps an eval of the list.
+type evalsItemModel struct {
+	ID        types.String         `tfsdk:"id"`
+	Name      types.String         `tfsdk:"name"`
+	Metadata  types.Map            `tfsdk:"metadata"`
+	CreatedAt types.Int64          `tfsdk:"created_at"`
+	LatestRun *evalsLatestRunModel `tfsdk:"latest_run"`
+}
+
+// evalsLatestRunModel maps the summary of the latest run of an eval.
+type evalsLatestRunModel struct {
+	ID           types.String  `tfsdk:"id"`
+	Name         types.String  `tfsdk:"name"`
+	Status       types.String  `tfsdk:"status"`
+	ReportURL    types.String  `tfsdk:"report_url"`
+	ResultCounts types.Object  `tfsdk:"result_counts"`
+	PassRate     types.Float64 `tfsdk:"pass_rate"`
+	CreatedAt    types.Int64   `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *evalsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_evals"
+}
+
+// Schema defines the schema for the data source.
+func (d *evalsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the OpenAI evals of the project, with a summary of their latest run.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of evals to list, the most recent first. Defaults to all the evals of the project.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"page_size": schema.Int64Attribute{
+				Description: "Number of evals requested per page, between 1 and 100. Defaults to 100.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"evals": schema.ListNestedAttribute{
+				Description: "Evals of the project, from the most recent.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the eval.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the eval.",
+							Computed:    true,
+						},
+						"metadata": schema.MapAttribute{
+							Description: "Key-value pairs attached to the eval.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Unix timestamp, in seconds, of the creation of the eval.",
+							Computed:    true,
+						},
+						"latest_run": schema.SingleNestedAttribute{
+							MarkdownDescription: "Summary of the latest run of the eval, `null` if the eval never ran.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Description: "ID of the eval run.",
+									Computed:    true,
+								},
+								"name": schema.StringAttribute{
+									Description: "Name of the eval run.",
+									Computed:    true,
+								},
+								"status": schema.StringAttribute{
+									MarkdownDescription: "Status of the eval run, such as `queued`, `in_progress`, `completed`, `failed` or `canceled`.",
+									Computed:            true,
+								},
+								"report_url": schema.StringAttribute{
+									Description: "URL of the report of the eval run in the OpenAI dashboard.",
+									Computed:    true,
+								},
+								"result_counts": schema.SingleNestedAttribute{
+									Description: "Number of evaluated items, per result.",
+									Computed:    true,
+									Attributes: map[string]schema.Attribute{
+										"total": schema.Int64Attribute{
+											Description: "Number of evaluated items.",
+											Computed:    true,
+										},
+										"passed": schema.Int64Attribute{
+											Description: "Number of items passing all graders.",
+											Computed:    true,
+										},
+										"failed": schema.Int64Attribute{
+											Description: "Number of items failing at least one grader.",
+											Computed:    true,
+										},
+										"errored": schema.Int64Attribute{
+											Description: "Number of items that could not be evaluated.",
+											Computed:    true,
+										},
+									},
+								},
+								"pass_rate": schema.Float64Attribute{
+									Description: "Ratio, between 0 and 1, of the evaluated items passing all graders.",
+									Computed:    true,
+								},
+								"created_at": schema.Int64Attribute{
+									Description: "Unix timestamp, in seconds, of the creation of the eval run.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *evalsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *evalsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data evalsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageSize := int64(100)
+	if !data.PageSize.IsNull() {
+		pageSize = data.PageSize.ValueInt64()
+	}
+
+	evals, err := d.client.listEvals(ctx, data.Limit.ValueInt64(), pageSize)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to list OpenAI evals", "", err)
+		return
+	}
+
+	// The latest run of each eval is a request of its own, send a few of them
+	// at once for the projects with many evals.
+	runs := make([]*evalRunObject, len(evals))
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(evalsConcurrency)
+	for i, eval := range evals {
+		group.Go(func() error {
+			var page struct {
+				Data []evalRunObject `json:"data"`
+			}
+			query := url.Values{"order": {"desc"}, "limit": {"1"}}
+			if err := d.client.doJSON(groupCtx, http.MethodGet, "/evals/"+eval.ID+"/runs?"+query.Encode(), nil, &page); err != nil {
+				return err
+			}
+
+			if len(page.Data) > 0 {
+				runs[i] = &page.Data[0]
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to list OpenAI eval runs", "", err)
+		return
+	}
+
+	data.Evals = []evalsItemModel{}
+	for i, eval := range evals {
+		item := evalsItemModel{
+			ID:        types.StringValue(eval.ID),
+			Name:      types.StringValue(eval.Name),
+			CreatedAt: types.Int64Value(eval.CreatedAt),
+		}
+
+		if eval.Metadata == nil {
+			eval.Metadata = map[string]string{}
+		}
+		item.Metadata, diags = types.MapValueFrom(ctx, types.StringType, eval.Metadata)
+		resp.Diagnostics.Append(diags...)
+
+		if run := runs[i]; run != nil {
+			item.LatestRun = &evalsLatestRunModel{
+				ID:        types.StringValue(run.ID),
+				Name:      types.StringValue(run.Name),
+				Status:    types.StringValue(run.Status),
+				ReportURL: types.StringValue(run.ReportURL),
+				PassRate:  types.Float64Value(run.passRate()),
+				CreatedAt: types.Int64Value(run.CreatedAt),
+			}
+			item.LatestRun.ResultCounts, diags = run.resultCounts()
+			resp.Diagnostics.Append(diags...)
+		}
+
+		data.Evals = append(data.Evals, item)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listEvals lists the evals of the project, from the most recent, up to limit
+// evals when limit is not 0. The evals are requested by pages of pageSize.
+func (c *openaiClient) listEvals(ctx context.Context, limit, pageSize int64) ([]evalObject, error) {
+	var evals []evalObject
+
+	query := url.Values{"order": {"desc"}, "limit": {strconv.FormatInt(pageSize, 10)}}
+	for {
+		var page struct {
+			Data    []evalObject `json:"data"`
+			HasMore bool         `json:"has_more"`
+			LastID  string       `json:"last_id"`
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/evals?"+query.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+
+		evals = append(evals, page.Data...)
+		if limit > 0 && int64(len(evals)) >= limit {
+			return evals[:limit], nil
+		}
+		if !page.HasMore || page.LastID == "" {
+			return evals, nil
+		}
+		query.Set("after", page.LastID)
+	}
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &fileDataSource{}
+	_ datasource.DataSourceWithConfigure      = &fileDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &fileDataSource{}
+)
+
+// NewFileDataSource is a helper function to simplify the provider implementation.
+func NewFileDataSource() datasource.DataSource {
+	return &fileDataSource{}
+}
+
+// fileDataSource is the data source implementation.
+type fileDataSource struct {
+	client *openaiClient
+}
+
+// fileDataSourceModel maps the data source schema data.
+type fileDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Filename  types.String `tfsdk:"filename"`
+	Purpose   types.String `tfsdk:"purpose"`
+	Bytes     types.Int64  `tfsdk:"bytes"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.Int64  `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *fileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+// Schema defines the schema for the data source.
+func (d *fileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches a file uploaded to OpenAI, by `id` or by `filename` and `purpose`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the file. Exactly one of `id` or `filename` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "Name of the file. Exactly one of `id` or `filename` must be set, the name must match exactly one file with the given `purpose`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"purpose": schema.StringAttribute{
+				MarkdownDescription: "Purpose of the file, one of `assistants`, `batch`, `fine-tune`, `vision`, `user_data` or `evals`. Required with `filename`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(filePurposes...),
+				},
+			},
+			"bytes": schema.Int64Attribute{
+				Description: "Size of the file, in bytes.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the file, such as `uploaded`, `processed` or `error`.",
+				Computed:            true,
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the file.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *fileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig ensures the file is looked up either by id, or by filename
+// and purpose.
+func (d *fileDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config fileDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ID.IsNull() && !config.Filename.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filename"),
+			"Conflicting file lookup",
+			"Only one of id or filename can be set.",
+		)
+	}
+
+	if config.ID.IsNull() && config.Filename.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing file lookup",
+			"One of id or filename must be set.",
+		)
+	}
+
+	if !config.Filename.IsNull() && config.Purpose.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("purpose"),
+			"Missing file purpose",
+			"The purpose must be set to look up a file by filename.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *fileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data fileDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var file fileObject
+	if data.ID.IsNull() {
+		files, err := d.client.listFiles(ctx, data.Purpose.ValueString(), 0)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Unable to list OpenAI files", "", err)
+			return
+		}
+
+		var ids []string
+		for _, candidate := range files {
+			if candidate.Filename == data.Filename.ValueString() {
+				file = candidate
+				ids = append(ids, candidate.ID)
+			}
+		}
+
+		if len(ids) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("filename"),
+				"File not found",
+				fmt.Sprintf("No file with the purpose %q is named %q.", data.Purpose.ValueString(), data.Filename.ValueString()),
+			)
+			return
+		}
+		if len(ids) > 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("filename"),
+				"Multiple files found",
+				fmt.Sprintf("%d files with the purpose %q are named %q: %s. Use id to select one of them.", len(ids), data.Purpose.ValueString(), data.Filename.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	} else {
+		err := d.client.doJSON(ctx, http.MethodGet, "/files/"+data.ID.ValueString(), nil, &file)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Unable to read OpenAI file", "", err)
+			return
+		}
+	}
+
+	data.ID = types.StringValue(file.ID)
+	data.Filename = types.StringValue(file.Filename)
+	data.Purpose = types.StringValue(file.Purpose)
+	data.Bytes = types.Int64Value(file.Bytes)
+	data.Status = types.StringValue(file.Status)
+	data.CreatedAt = types.Int64Value(file.CreatedAt)
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,663 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &fileDirectoryResource{}
+	_ resource.ResourceWithConfigure      = &fileDirectoryResource{}
+	_ resource.ResourceWithModifyPlan     = &fileDirectoryResource{}
+	_ resource.ResourceWithValidateConfig = &fileDirectoryResource{}
+)
+
+// fileDirectoryConcurrency is the number of files of a directory uploaded, or
+// deleted, at once.
+const fileDirectoryConcurrency = 4
+
+// NewFileDirectoryResource is a helper function to simplify the provider implementation.
+func NewFileDirectoryResource() resource.Resource {
+	return &fileDirectoryResource{}
+}
+
+// fileDirectoryResource is the resource implementation.
+type fileDirectoryResource struct {
+	client *openaiClient
+}
+
+// fileDirectoryResourceModel maps the resource schema data.
+type fileDirectoryResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Path         types.String   `tfsdk:"path"`
+	Patterns     types.List     `tfsdk:"patterns"`
+	Purpose      types.String   `tfsdk:"purpose"`
+	SourceSHA256 types.Map      `tfsdk:"source_sha256"`
+	FileIDs      types.Map      `tfsdk:"file_ids"`
+	Project      types.String   `tfsdk:"project"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *fileDirectoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_directory"
+}
+
+// Schema defines the schema for the resource.
+func (r *fileDirectoryResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads the files of a local directory matching glob patterns to the OpenAI Files API, e.g. the documents of a knowledge base. Each file is tracked by its path relative to the directory and by the checksum of its content: the new files are uploaded, the changed files are uploaded again and the removed files are deleted, without uploading the unchanged files again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Random identifier of the resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to the local directory. Changing it only uploads the files whose relative path or content differ.",
+				Required:            true,
+			},
+			"patterns": schema.ListAttribute{
+				MarkdownDescription: "Glob patterns of the paths of the files to upload, relative to `path` and separated by `/`, e.g. `*.md` or `docs/**/*.pdf`. `**` matches any number of directories. Defaults to all the files of the directory.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"purpose": schema.StringAttribute{
+				MarkdownDescription: "Intended purpose of the files, either `assistants`, `batch`, `fine-tune`, `vision`, `user_data` or `evals`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(filePurposes...),
+				},
+			},
+			"source_sha256": schema.MapAttribute{
+				Description: "SHA-256 checksums of the content of the uploaded files, by path relative to the directory.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"file_ids": schema.MapAttribute{
+				Description: "IDs of the uploaded files, by path relative to the directory.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the files are uploaded to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *fileDirectoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures the glob patterns are well-formed.
+func (r *fileDirectoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config fileDirectoryResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Patterns.IsNull() || config.Patterns.IsUnknown() {
+		return
+	}
+
+	for i, pattern := range config.Patterns.Elements() {
+		value, ok := pattern.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if err := validateFilePattern(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("patterns").AtListIndex(i),
+				"Invalid glob pattern",
+				fmt.Sprintf("%q must be a glob pattern relative to path, e.g. *.md or docs/**/*.pdf: %s", value.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+// ModifyPlan computes the checksums of the files of the directory, so the
+// changes of the files upload them again.
+func (r *fileDirectoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan fileDirectoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.Path.IsUnknown() || plan.Patterns.IsUnknown() {
+		return
+	}
+
+	patterns, diags := plan.patterns(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The directory may not be readable yet, e.g. when its files are generated
+	// by another resource during apply, its files are only listed then.
+	checksums, err := localDirectoryChecksums(plan.Path.ValueString(), patterns)
+	if err != nil {
+		return
+	}
+
+	previous := map[string]string{}
+	if !req.State.Raw.IsNull() {
+		var state fileDirectoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		resp.Diagnostics.Append(state.SourceSHA256.ElementsAs(ctx, &previous, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if maps.Equal(previous, checksums) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), state.SourceSHA256)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_ids"), state.FileIDs)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), checksums)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_ids"), types.MapUnknown(types.StringType))...)
+}
+
+// Create a new resource.
+func (r *fileDirectoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan fileDirectoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	id, err := newCreateMarker()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating file directory", "Could not generate the identifier of the directory: "+err.Error())
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	if !r.sync(ctx, &plan, map[string]string{}, map[string]string{}, &resp.Diagnostics) {
+		return
+	}
+
+	// Set state to fully populated data, including the files uploaded before
+	// an error, so they are deleted with the resource.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read resource information.
+func (r *fileDirectoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state fileDirectoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	checksums, fileIDs, diags := state.uploaded(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A single listing of the files is cheaper than reading every file of a
+	// large directory.
+	files, err := r.client.listFiles(ctx, state.Purpose.ValueString(), 0)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI files", "Could not list OpenAI files: ", err)
+		return
+	}
+
+	existing := make(map[string]bool, len(files))
+	for _, file := range files {
+		existing[file.ID] = true
+	}
+
+	// The files deleted outside of Terraform are uploaded again by the next
+	// apply.
+	for relativePath, fileID := range fileIDs {
+		if !existing[fileID] {
+			delete(fileIDs, relativePath)
+			delete(checksums, relativePath)
+		}
+	}
+
+	resp.Diagnostics.Append(state.setUploaded(ctx, checksums, fileIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update uploads the new and changed files of the directory, and deletes the
+// removed files.
+func (r *fileDirectoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state fileDirectoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	checksums, fileIDs, diags := state.uploaded(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.sync(ctx, &plan, checksums, fileIDs, &resp.Diagnostics) {
+		return
+	}
+
+	// Set state to the files actually uploaded, even after an error, so the
+	// next apply resumes the synchronization.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fileDirectoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state fileDirectoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	_, fileIDs, diags := state.uploaded(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make([]string, 0, len(fileIDs))
+	for _, fileID := range fileIDs {
+		ids = append(ids, fileID)
+	}
+
+	if err := r.client.deleteFiles(ctx, ids); err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI files", "Could not delete files, unexpected error: ", err)
+		return
+	}
+}
+
+// sync uploads the files of the directory of the plan that are not in the
+// uploaded files or whose checksum changed, then deletes the replaced files
+// and the files removed from the directory. The checksums and the IDs of the
+// files uploaded are set in the plan, even when an error is added to diags,
+// and sync reports whether they were, i.e. whether the plan must be saved.
+func (r *fileDirectoryResource) sync(ctx context.Context, plan *fileDirectoryResourceModel, checksums, fileIDs map[string]string, diags *diag.Diagnostics) bool {
+	patterns, d := plan.patterns(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false
+	}
+
+	root := plan.Path.ValueString()
+	current, err := localDirectoryChecksums(root, patterns)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("path"),
+			"Error reading directory",
+			"Could not list the files of the directory, unexpected error: "+err.Error(),
+		)
+		return false
+	}
+
+	var planned map[string]string
+	if !plan.SourceSHA256.IsUnknown() {
+		diags.Append(plan.SourceSHA256.ElementsAs(ctx, &planned, false)...)
+		if diags.HasError() {
+			return false
+		}
+		if !maps.Equal(planned, current) {
+			diags.AddAttributeError(
+				path.Root("path"),
+				"Directory changed during apply",
+				"The files of the directory changed since the plan, plan the changes again.",
+			)
+			return false
+		}
+	}
+
+	var changed []string
+	for relativePath, checksum := range current {
+		if checksums[relativePath] != checksum || fileIDs[relativePath] == "" {
+			changed = append(changed, relativePath)
+		}
+	}
+	sort.Strings(changed)
+
+	// Upload the new versions before deleting the previous ones, so the files
+	// are never missing.
+	var mutex sync.Mutex
+	var replaced []string
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(fileDirectoryConcurrency)
+	for _, relativePath := range changed {
+		group.Go(func() error {
+			source, content, err := openLocalFile(filepath.Join(root, filepath.FromSlash(relativePath)))
+			if err != nil {
+				return err
+			}
+			defer source.Close()
+
+			checksum, err := readerSHA256(io.NewSectionReader(content, 0, content.Size()))
+			if err != nil {
+				return err
+			}
+			if checksum != current[relativePath] {
+				return fmt.Errorf("the file %s changed since the directory was listed", relativePath)
+			}
+
+			file, err := r.client.uploadFile(groupCtx, filepath.Base(relativePath), plan.Purpose.ValueString(), content)
+			if err != nil {
+				return fmt.Errorf("could not upload %s: %w", relativePath, err)
+			}
+
+			// The resources depending on the files would otherwise fail
+			// with a 404 Not Found, a file not readable yet is not an error.
+			_ = r.client.waitReadable(groupCtx, "/files/"+file.ID)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if previous := fileIDs[relativePath]; previous != "" {
+				replaced = append(replaced, previous)
+			}
+			fileIDs[relativePath] = file.ID
+			checksums[relativePath] = current[relativePath]
+			return nil
+		})
+	}
+	uploadErr := group.Wait()
+	if uploadErr != nil {
+		addAPIError(diags, "Error uploading files", "Could not upload the files of the directory, unexpected error: ", uploadErr)
+	}
+
+	// The files removed from the directory are only deleted once the whole
+	// directory is uploaded.
+	obsolete := replaced
+	var removed []string
+	if uploadErr == nil {
+		for relativePath, fileID := range fileIDs {
+			if _, ok := current[relativePath]; !ok {
+				removed = append(removed, relativePath)
+				obsolete = append(obsolete, fileID)
+			}
+		}
+	}
+
+	if err := r.client.deleteFiles(ctx, obsolete); err != nil {
+		addAPIError(diags, "Error deleting files", "Could not delete the previous files of the directory, unexpected error: ", err)
+	} else {
+		for _, relativePath := range removed {
+			delete(fileIDs, relativePath)
+			delete(checksums, relativePath)
+		}
+	}
+
+	diags.Append(plan.setUploaded(ctx, checksums, fileIDs)...)
+	return true
+}
+
+// deleteFiles deletes the files, ignoring the files already deleted.
+func (c *openaiClient) deleteFiles(ctx context.Context, fileIDs []string) error {
+	var mutex sync.Mutex
+	var errs []error
+	var group errgroup.Group
+	group.SetLimit(fileDirectoryConcurrency)
+	for _, fileID := range fileIDs {
+		group.Go(func() error {
+			err := c.doJSON(ctx, http.MethodDelete, "/files/"+fileID, nil, nil)
+			if err != nil && errorStatusCode(err) != http.StatusNotFound {
+				mutex.Lock()
+				defer mutex.Unlock()
+				errs = append(errs, fmt.Errorf("could not delete %s: %w", fileID, err))
+			}
+			return nil
+		})
+	}
+	group.Wait()
+
+	return errors.Join(errs...)
+}
+
+// patterns returns the glob patterns of the files of the directory, all the
+// files by default.
+func (m fileDirectoryResourceModel) patterns(ctx context.Context) ([]string, diag.Diagnostics) {
+	if m.Patterns.IsNull() {
+		return []string{"**"}, nil
+	}
+
+	var patterns []string
+	diags := m.Patterns.ElementsAs(ctx, &patterns, false)
+	return patterns, diags
+}
+
+// uploaded returns the checksums and the IDs of the files uploaded, by path
+// relative to the directory.
+func (m fileDirectoryResourceModel) uploaded(ctx context.Context) (map[string]string, map[string]string, diag.Diagnostics) {
+	checksums := map[string]string{}
+	fileIDs := map[string]string{}
+
+	diags := m.SourceSHA256.ElementsAs(ctx, &checksums, false)
+	diags.Append(m.FileIDs.ElementsAs(ctx, &fileIDs, false)...)
+
+	return checksums, fileIDs, diags
+}
+
+// setUploaded sets the checksums and the IDs of the files uploaded.
+func (m *fileDirectoryResourceModel) setUploaded(ctx context.Context, checksums, fileIDs map[string]string) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.SourceSHA256, d = types.MapValueFrom(ctx, types.StringType, checksums)
+	diags.Append(d...)
+	m.FileIDs, d = types.MapValueFrom(ctx, types.StringType, fileIDs)
+	diags.Append(d...)
+
+	return diags
+}
+
+// localDirectoryChecksums returns the SHA-256 checksums of the content of the
+// regular files of the directory root matching any of the patterns, by path
+// relative to root, separated by "/".
+func localDirectoryChecksums(root string, patterns []string) (map[string]string, error) {
+	checksums := map[string]string{}
+
+	err := filepath.WalkDir(root, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(root, name)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+
+		for _, pattern := range patterns {
+			matched, err := matchFilePattern(pattern, relativePath)
+			if err != nil {
+				return err
+			}
+			if matched {
+				checksum, err := localFilesSHA256(name)
+				if err != nil {
+					return err
+				}
+				checksums[relativePath] = checksum
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	return checksums, err
+}
+
+// validateFilePattern returns an error when the glob pattern is malformed or
+// is not relative.
+func validateFilePattern(pattern string) error {
+	if strings.HasPrefix(pattern, "/") {
+		return errors.New("the pattern is absolute")
+	}
+
+	// filepath.Match validates the whole pattern, even when the name does not
+	// match it.
+	for _, element := range strings.Split(pattern, "/") {
+		if _, err := filepath.Match(element, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchFilePattern reports whether the relative path name, separated by "/",
+// matches the glob pattern. The elements of the pattern are matched with
+// filepath.Match against the elements of the path, except "**" which matches
+// any number of elements.
+func matchFilePattern(pattern, name string) (bool, error) {
+	return matchPathElements(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// matchPathElements is matchFilePattern with the elements of the pattern and
+// of the path.
+func matchPathElements(pattern, name []string) (bool, error) {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matched, err := matchPathElements(pattern[1:], name[i:]); matched || err != nil {
+					return matched, err
+				}
+			}
+			return false, nil
+		}
+
+		if len(name) == 0 {
+			return false, nil
+		}
+		matched, err := filepath.Match(pattern[0], name[0])
+		if err != nil || !matched {
+			return false, err
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0, nil
+}
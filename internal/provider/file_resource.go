@@ -0,0 +1,557 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &fileResource{}
+	_ resource.ResourceWithConfigure      = &fileResource{}
+	_ resource.ResourceWithImportState    = &fileResource{}
+	_ resource.ResourceWithModifyPlan     = &fileResource{}
+	_ resource.ResourceWithValidateConfig = &fileResource{}
+)
+
+// filePurposes are the purposes of the files accepted by the Files API.
+var filePurposes = []string{"assistants", "batch", "fine-tune", "vision", "user_data", "evals"}
+
+// NewFileResource is a helper function to simplify the provider implementation.
+func NewFileResource() resource.Resource {
+	return &fileResource{}
+}
+
+// fileResource is the resource implementation.
+type fileResource struct {
+	client *openaiClient
+}
+
+// fileResourceModel maps the resource schema data.
+type fileResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	SourcePath    types.String   `tfsdk:"source_path"`
+	SourceURL     types.String   `tfsdk:"source_url"`
+	Content       types.String   `tfsdk:"content"`
+	ContentBase64 types.String   `tfsdk:"content_base64"`
+	Filename      types.String   `tfsdk:"filename"`
+	Purpose       types.String   `tfsdk:"purpose"`
+	SourceSHA256  types.String   `tfsdk:"source_sha256"`
+	Bytes         types.Int64    `tfsdk:"bytes"`
+	CreatedAt     types.Int64    `tfsdk:"created_at"`
+	Status        types.String   `tfsdk:"status"`
+	Project       types.String   `tfsdk:"project"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// fileObject is a file returned by the Files API.
+type fileObject struct {
+	ID        string `json:"id"`
+	Filename  string `json:"filename"`
+	Purpose   string `json:"purpose"`
+	Bytes     int64  `json:"bytes"`
+	CreatedAt int64  `json:"created_at"`
+	Status    string `json:"status"`
+}
+
+// Metadata returns the resource type name.
+func (r *fileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+// Schema defines the schema for the resource.
+func (r *fileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a file to the OpenAI Files API, from the local filesystem or from an inline content, to share it between assistants, vector stores, batches or fine-tuning jobs. The file is uploaded again when its content changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the local file to upload. Conflicts with `source_url`, `content` and `content_base64`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_url": schema.StringAttribute{
+				MarkdownDescription: "HTTP or HTTPS URL of the file to upload, downloaded during apply, e.g. from an object storage or a CMS. Set `source_sha256` to verify the downloaded content, and to upload the file again when it changes. Conflicts with `source_path`, `content` and `content_base64`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sourceURLPattern, "must be an HTTP or HTTPS URL"),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the file to upload, e.g. rendered with `templatefile` or `jsonencode`. Conflicts with `source_path`, `source_url` and `content_base64`, requires `filename`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded content of the file to upload, for the binary files, e.g. read with `filebase64`. Conflicts with `source_path`, `source_url` and `content`, requires `filename`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "Name of the uploaded file, whose extension tells OpenAI its format. Defaults to the name of `source_path`, or to the last element of the path of `source_url`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"purpose": schema.StringAttribute{
+				MarkdownDescription: "Intended purpose of the file, either `assistants`, `batch`, `fine-tune`, `vision`, `user_data` or `evals`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(filePurposes...),
+				},
+			},
+			"source_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 checksum of the content of the file. A change of the checksum uploads the file again. Only configurable with `source_url`, the downloaded content must match it.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sha256Pattern, "must be a lowercase hex encoded SHA-256 checksum"),
+				},
+			},
+			"bytes": schema.Int64Attribute{
+				Description: "Size of the file, in bytes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the upload of the file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the file, such as `uploaded`, `processed` or `error`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the file is uploaded to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *fileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures exactly one source of the file is set, and that
+// inline contents are named.
+func (r *fileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config fileResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fileSources{
+		Path:          config.SourcePath,
+		URL:           config.SourceURL,
+		Content:       config.Content,
+		ContentBase64: config.ContentBase64,
+		SHA256:        config.SourceSHA256,
+	}.validate(&resp.Diagnostics)
+
+	if (!config.Content.IsNull() || !config.ContentBase64.IsNull()) && config.Filename.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filename"),
+			"Missing filename",
+			"filename must be set with content or content_base64, OpenAI tells the format of the file from its extension.",
+		)
+	}
+
+	if !config.SourceURL.IsNull() && !config.SourceURL.IsUnknown() && config.Filename.IsNull() && sourceURLFilename(config.SourceURL.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filename"),
+			"Missing filename",
+			"filename must be set when the path of source_url has no file name, OpenAI tells the format of the file from its extension.",
+		)
+	}
+}
+
+// ModifyPlan computes the checksum of the content of the file, so a change of
+// the local file uploads it again.
+func (r *fileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan fileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.SourcePath.IsUnknown() || plan.SourceURL.IsUnknown() || plan.Content.IsUnknown() || plan.ContentBase64.IsUnknown() {
+		return
+	}
+
+	if plan.Filename.IsUnknown() && !plan.SourcePath.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filename"), filepath.Base(plan.SourcePath.ValueString()))...)
+	}
+
+	// The files downloaded from a URL are only downloaded during apply, their
+	// checksum is either configured or computed then.
+	if !plan.SourceURL.IsNull() {
+		if plan.Filename.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filename"), sourceURLFilename(plan.SourceURL.ValueString()))...)
+		}
+		return
+	}
+
+	if !plan.Content.IsNull() || !plan.ContentBase64.IsNull() {
+		content, err := inlineFileContent(plan.Content, plan.ContentBase64)
+		if err == nil {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), sha256Hex(content))...)
+		}
+		return
+	}
+
+	modifyPlanLocalFilesChecksum(ctx, req, resp, path.Root("source_sha256"), plan.SourcePath.ValueString())
+}
+
+// Create a new resource.
+func (r *fileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan fileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	inlineContent, err := inlineFileContent(plan.Content, plan.ContentBase64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content_base64"),
+			"Invalid base64 content",
+			"Could not create file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	content := contentSection(inlineContent)
+
+	// The local file is streamed rather than read in memory, it may be larger
+	// than the memory available.
+	if !plan.SourcePath.IsNull() {
+		var source *os.File
+		source, content, err = openLocalFile(plan.SourcePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_path"),
+				"Error reading file content",
+				"Could not create file, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		defer source.Close()
+	}
+
+	var checksum string
+	if !plan.SourceURL.IsNull() {
+		var downloaded *os.File
+		downloaded, content, checksum, err = downloadFile(ctx, plan.SourceURL.ValueString(), plan.SourceSHA256.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_url"),
+				"Error downloading file content",
+				"Could not create file, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		defer removeDownloadedFile(downloaded)
+	}
+
+	// The checksum of a downloaded file is computed while downloading it.
+	if checksum == "" {
+		checksum, err = readerSHA256(io.NewSectionReader(content, 0, content.Size()))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_path"),
+				"Error reading file content",
+				"Could not create file, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	filename := plan.Filename.ValueString()
+	if plan.Filename.IsUnknown() || filename == "" {
+		filename = filepath.Base(plan.SourcePath.ValueString())
+	}
+
+	file, err := r.client.uploadFile(ctx, filename, plan.Purpose.ValueString(), content)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating file", "Could not upload file, unexpected error: ", err)
+		return
+	}
+
+	// Wait for the file to be readable, the resources depending on it would
+	// otherwise fail with a 404 Not Found.
+	if err := r.client.waitReadable(ctx, "/files/"+file.ID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"File not readable yet",
+			"The file "+file.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.SourceSHA256 = types.StringValue(checksum)
+	plan.refresh(file)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *fileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state fileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed file value from OpenAI
+	var file fileObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/files/"+state.ID.ValueString(), nil, &file)
+	if removeNotFound(ctx, resp, err, "OpenAI file "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI file", "Could not read OpenAI file ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	state.refresh(file)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *fileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Files cannot be updated, any change uploads the file again.
+	var plan fileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *fileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state fileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing file
+	err := r.client.doJSON(ctx, http.MethodDelete, "/files/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI file", "Could not delete file, unexpected error: ", err)
+		return
+	}
+}
+
+func (r *fileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept the import IDs of the other OpenAI providers, see
+	// fileIDPattern.
+	fileID := fileIDPattern.FindString(req.ID)
+	if fileID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: file_id, e.g. file-abc123. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fileID)...)
+}
+
+// refresh populates the computed attributes from the file returned by the
+// API.
+func (m *fileResourceModel) refresh(file fileObject) {
+	m.ID = types.StringValue(file.ID)
+	m.Filename = types.StringValue(file.Filename)
+	m.Purpose = types.StringValue(file.Purpose)
+	m.Bytes = types.Int64Value(file.Bytes)
+	m.CreatedAt = types.Int64Value(file.CreatedAt)
+	m.Status = types.StringValue(file.Status)
+}
+
+// uploadFile uploads content to the Files API with the name and the purpose,
+// or with the Uploads API when the file is too large for the Files API. The
+// content is streamed, the local files are not read in memory. Files have no
+// metadata, a file uploaded by a request whose response is lost is recognized
+// by its name and size instead.
+func (c *openaiClient) uploadFile(ctx context.Context, filename, purpose string, content *io.SectionReader) (fileObject, error) {
+	if content.Size() > maxFileUploadBytes {
+		return c.uploadFileInParts(ctx, filename, purpose, content)
+	}
+
+	started := time.Now()
+	return createOnce(ctx, func(ctx context.Context) (fileObject, error) {
+		var file fileObject
+		err := c.doMultipart(ctx, "/files", url.Values{
+			"purpose": {purpose},
+		}, []multipartFile{
+			{field: "file", name: filename, section: content},
+		}, &file)
+		return file, err
+	}, func(ctx context.Context) (fileObject, bool, error) {
+		uploaded, ok, err := c.findUploadedFile(ctx, filename, content.Size(), purpose, started)
+		return fileObject{
+			ID:        uploaded.ID,
+			Filename:  uploaded.FileName,
+			Purpose:   uploaded.Purpose,
+			Bytes:     int64(uploaded.Bytes),
+			CreatedAt: uploaded.CreatedAt,
+			Status:    uploaded.Status,
+		}, ok, err
+	})
+}
+
+// contentSection returns a section reader of an in-memory content, to upload
+// it like a local file.
+func contentSection(content []byte) *io.SectionReader {
+	return io.NewSectionReader(bytes.NewReader(content), 0, int64(len(content)))
+}
+
+// findUploadedFile looks up a file uploaded since started with the name, the
+// size and the purpose.
+func (c *openaiClient) findUploadedFile(ctx context.Context, name string, size int64, purpose string, started time.Time) (openai.File, bool, error) {
+	files, err := c.ListFiles(ctx)
+	if err != nil {
+		return openai.File{}, false, err
+	}
+
+	// Allow for a clock skew between the host and OpenAI.
+	since := started.Add(-time.Minute).Unix()
+	for _, file := range files.Files {
+		if file.FileName == name && int64(file.Bytes) == size && file.Purpose == purpose && file.CreatedAt >= since {
+			return file, true, nil
+		}
+	}
+
+	return openai.File{}, false, nil
+}
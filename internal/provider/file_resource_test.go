@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUploadFile(t *testing.T) {
+	server := newMockOpenAI(t)
+	client := server.client()
+	ctx := context.Background()
+
+	content := []byte(`{"custom_id":"request-1"}` + "\n")
+	file, err := client.uploadFile(ctx, testAccNamePrefix+"batch.jsonl", "batch", contentSection(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if file.Filename != testAccNamePrefix+"batch.jsonl" || file.Purpose != "batch" || file.Bytes != int64(len(content)) {
+		t.Errorf("unexpected file %+v", file)
+	}
+	if uploaded := string(server.files[file.ID].content); uploaded != string(content) {
+		t.Errorf("expected content %q, got %q", content, uploaded)
+	}
+
+	// An upload whose response is lost is found by its name and size.
+	found, ok, err := client.findUploadedFile(ctx, testAccNamePrefix+"batch.jsonl", int64(len(content)), "batch", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || found.ID != file.ID {
+		t.Errorf("expected to find %s, got %q (found: %t)", file.ID, found.ID, ok)
+	}
+
+	if _, ok, err := client.findUploadedFile(ctx, testAccNamePrefix+"batch.jsonl", 1, "batch", time.Now()); err != nil || ok {
+		t.Errorf("expected no file of another size, got found: %t, error: %v", ok, err)
+	}
+}
+
+func TestUploadLocalFile(t *testing.T) {
+	server := newMockOpenAI(t)
+	client := server.client()
+
+	content := []byte(`{"text":"local"}` + "\n")
+	path := filepath.Join(t.TempDir(), testAccNamePrefix+"local.jsonl")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	source, section, err := openLocalFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer source.Close()
+
+	file, err := client.uploadFile(context.Background(), filepath.Base(path), "assistants", section)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uploaded := string(server.files[file.ID].content); uploaded != string(content) {
+		t.Errorf("expected content %q, got %q", content, uploaded)
+	}
+
+	// The checksum of the file is the checksum of the uploaded content.
+	checksum, err := localFileSHA256(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uploadedChecksum, err := readerSHA256(bytes.NewReader(server.files[file.ID].content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checksum != uploadedChecksum {
+		t.Errorf("expected checksum %s, got %s", checksum, uploadedChecksum)
+	}
+}
+
+func TestAccFileResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed("openai_file", "/files/"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "openai_file" "test" {
+  content  = "{\"custom_id\": \"1\"}\n"
+  filename = %q
+  purpose  = "batch"
+}
+`, testAccNamePrefix+"file.jsonl"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openai_file.test", "id"),
+					resource.TestCheckResourceAttr("openai_file.test", "filename", testAccNamePrefix+"file.jsonl"),
+					resource.TestCheckResourceAttr("openai_file.test", "bytes", "18"),
+					resource.TestCheckResourceAttr("openai_file.test", "status", "processed"),
+				),
+			},
+			{
+				ResourceName:            "openai_file.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &filesDataSource{}
+	_ datasource.DataSourceWithConfigure = &filesDataSource{}
+)
+
+// NewFilesDataSource is a helper function to simplify the provider implementation.
+func NewFilesDataSource() datasource.DataSource {
+	return &filesDataSource{}
+}
+
+// filesDataSource is the data source implementation.
+type filesDataSource struct {
+	client *openaiClient
+}
+
+// filesDataSourceModel maps the data source schema data.
+type filesDataSourceModel struct {
+	Purpose      types.String     `tfsdk:"purpose"`
+	CreatedAfter types.Int64      `tfsdk:"created_after"`
+	Files        []filesItemModel `tfsdk:"files"`
+}
+
+// filesItemModel maps a file of the list.
+type filesItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Filename  types.String `tfsdk:"filename"`
+	Purpose   types.String `tfsdk:"purpose"`
+	Bytes     types.Int64  `tfsdk:"bytes"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.Int64  `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *filesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_files"
+}
+
+// Schema defines the schema for the data source.
+func (d *filesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the files uploaded to OpenAI.",
+		Attributes: map[string]schema.Attribute{
+			"purpose": schema.StringAttribute{
+				MarkdownDescription: "Only list the files with this purpose, one of `assistants`, `batch`, `fine-tune`, `vision`, `user_data` or `evals`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(filePurposes...),
+				},
+			},
+			"created_after": schema.Int64Attribute{
+				Description: "Only list the files created after this Unix timestamp, in seconds.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"files": schema.ListNestedAttribute{
+				Description: "Files uploaded to OpenAI, from the most recent.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the file.",
+							Computed:    true,
+						},
+						"filename": schema.StringAttribute{
+							Description: "Name of the file.",
+							Computed:    true,
+						},
+						"purpose": schema.StringAttribute{
+							Description: "Purpose of the file.",
+							Computed:    true,
+						},
+						"bytes": schema.Int64Attribute{
+							Description: "Size of the file, in bytes.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the file, such as `uploaded`, `processed` or `error`.",
+							Computed:            true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Unix timestamp, in seconds, of the creation of the file.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *filesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *filesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data filesDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files, err := d.client.listFiles(ctx, data.Purpose.ValueString(), data.CreatedAfter.ValueInt64())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to list OpenAI files", "", err)
+		return
+	}
+
+	data.Files = []filesItemModel{}
+	for _, file := range files {
+		data.Files = append(data.Files, filesItemModel{
+			ID:        types.StringValue(file.ID),
+			Filename:  types.StringValue(file.Filename),
+			Purpose:   types.StringValue(file.Purpose),
+			Bytes:     types.Int64Value(file.Bytes),
+			Status:    types.StringValue(file.Status),
+			CreatedAt: types.Int64Value(file.CreatedAt),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listFiles lists the files, from the most recent, with the given purpose
+// when purpose is not empty and created after createdAfter when createdAfter
+// is not 0.
+func (c *openaiClient) listFiles(ctx context.Context, purpose string, createdAfter int64) ([]fileObject, error) {
+	var files []fileObject
+
+	query := url.Values{"order": {"desc"}, "limit": {"1000"}}
+	if purpose != "" {
+		query.Set("purpose", purpose)
+	}
+	for {
+		var page struct {
+			Data    []fileObject `json:"data"`
+			HasMore bool         `json:"has_more"`
+			LastID  string       `json:"last_id"`
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/files?"+query.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+
+		// The files are sorted from the most recent, the remaining pages only
+		// have older files.
+		for _, file := range page.Data {
+			if createdAfter > 0 && file.CreatedAt <= createdAfter {
+				return files, nil
+			}
+			files = append(files, file)
+		}
+		if !page.HasMore || page.LastID == "" {
+			return files, nil
+		}
+		query.Set("after", page.LastID)
+	}
+}
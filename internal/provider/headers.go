@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"net/http"
+)
+
+// headersTransport adds default headers to the requests, such as the headers
+// required by a corporate gateway in front of the OpenAI API.
+type headersTransport struct {
+	next    http.RoundTripper
+	headers map[string]string
+}
+
+// newHeadersTransport wraps next with the addition of the headers. The
+// requests are left untouched when there are no headers.
+func newHeadersTransport(next http.RoundTripper, headers map[string]string) http.RoundTripper {
+	if len(headers) == 0 {
+		return next
+	}
+
+	return &headersTransport{
+		next:    next,
+		headers: headers,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request.
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		// The headers set by the provider, e.g. Authorization, take
+		// precedence.
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+
+	return t.next.RoundTrip(req)
+}
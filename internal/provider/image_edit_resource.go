@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &imageEditResource{}
+	_ resource.ResourceWithConfigure  = &imageEditResource{}
+	_ resource.ResourceWithModifyPlan = &imageEditResource{}
+)
+
+// NewImageEditResource is a helper function to simplify the provider implementation.
+func NewImageEditResource() resource.Resource {
+	return &imageEditResource{}
+}
+
+// imageEditResource is the resource implementation.
+type imageEditResource struct {
+	client *openaiClient
+}
+
+// imageEditResourceModel maps the resource schema data.
+type imageEditResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	ImagePath    types.String   `tfsdk:"image_path"`
+	MaskPath     types.String   `tfsdk:"mask_path"`
+	Prompt       types.String   `tfsdk:"prompt"`
+	Model        types.String   `tfsdk:"model"`
+	Size         types.String   `tfsdk:"size"`
+	OutputPath   types.String   `tfsdk:"output_path"`
+	SourceSHA256 types.String   `tfsdk:"source_sha256"`
+	OutputSHA256 types.String   `tfsdk:"output_sha256"`
+	B64JSON      types.String   `tfsdk:"b64_json"`
+	Project      types.String   `tfsdk:"project"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *imageEditResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_edit"
+}
+
+// Schema defines the schema for the resource.
+func (r *imageEditResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Edits a local image from a prompt, optionally limited to the transparent areas of a mask. The image is written to `output_path` when set, and is always available base64 encoded in `b64_json`. The image is only edited again when one of the arguments, or the content of the source image or mask, changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "SHA-256 checksum of the edited image.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_path": schema.StringAttribute{
+				Description: "Path to the image to edit.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mask_path": schema.StringAttribute{
+				MarkdownDescription: "Path to a PNG image whose fully transparent areas indicate where the image should be edited. Must have the same dimensions as the image at `image_path`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prompt": schema.StringAttribute{
+				Description: "Text description of the desired image.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Model used to edit the image, such as `dall-e-2` or `gpt-image-1`. Defaults to `dall-e-2`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.StringAttribute{
+				MarkdownDescription: "Size of the edited image, such as `256x256`, `512x512` or `1024x1024`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the file the edited image is written to. Leave unset to only use `b64_json`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the source image and mask. A change of the checksum edits the image again.",
+				Computed:    true,
+			},
+			"output_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the edited image.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"b64_json": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded content of the edited image. Marked as sensitive to keep megabytes of base64 out of the plan output, it is still stored in state.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the image edit is billed to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *imageEditResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the checksum of the source image and mask, so a change
+// of their content edits the image again, and checks the model is available
+// to the API key.
+func (r *imageEditResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.modifyPlanModel(ctx, req, resp)
+
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan imageEditResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.ImagePath.IsUnknown() || plan.MaskPath.IsUnknown() {
+		return
+	}
+
+	modifyPlanLocalFilesChecksum(ctx, req, resp, path.Root("source_sha256"), plan.sourcePaths()...)
+}
+
+// Create a new resource.
+func (r *imageEditResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan imageEditResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	var files []multipartFile
+	for _, source := range []struct {
+		field string
+		path  types.String
+	}{
+		{"image", plan.ImagePath},
+		{"mask", plan.MaskPath},
+	} {
+		if source.path.IsNull() {
+			continue
+		}
+
+		content, err := os.ReadFile(source.path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(source.field+"_path"),
+				"Error reading file content",
+				"Could not edit image, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		files = append(files, multipartFile{field: source.field, name: filepath.Base(source.path.ValueString()), content: content})
+	}
+
+	fields := url.Values{
+		"prompt": {plan.Prompt.ValueString()},
+		"model":  {plan.Model.ValueString()},
+		"size":   {plan.Size.ValueString()},
+		"n":      {"1"},
+	}
+
+	// GPT image models always return base64 images and reject response_format.
+	if !strings.HasPrefix(plan.Model.ValueString(), "gpt-image-") {
+		fields.Set("response_format", "b64_json")
+	}
+
+	var image imageResponse
+	err := r.client.doMultipart(ctx, "/images/edits", fields, files, &image)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error editing image", "Could not edit image, unexpected error: ", err)
+		return
+	}
+
+	content, err := saveImage(image, plan.OutputPath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error saving image",
+			"Could not edit image, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sourceChecksum, err := localFilesSHA256(plan.sourcePaths()...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file content",
+			"Could not edit image, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	checksum := sha256Hex(content)
+	plan.ID = types.StringValue(checksum)
+	plan.SourceSHA256 = types.StringValue(sourceChecksum)
+	plan.OutputSHA256 = types.StringValue(checksum)
+	plan.B64JSON = types.StringValue(image.Data[0].B64JSON)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *imageEditResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state imageEditResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Edit the image again when the file has been removed.
+	if !state.OutputPath.IsNull() && !localFileExists(state.OutputPath.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *imageEditResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires a replacement, there is nothing to send to OpenAI.
+	var plan imageEditResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *imageEditResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state imageEditResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.OutputPath.IsNull() {
+		return
+	}
+
+	if err := removeLocalFile(state.OutputPath.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting image file",
+			"Could not delete image file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// sourcePaths returns the paths to the source image and, when set, the mask.
+func (m imageEditResourceModel) sourcePaths() []string {
+	paths := []string{m.ImagePath.ValueString()}
+	if !m.MaskPath.IsNull() {
+		paths = append(paths, m.MaskPath.ValueString())
+	}
+
+	return paths
+}
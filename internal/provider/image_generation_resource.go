@@ -0,0 +1,489 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &imageGenerationResource{}
+	_ resource.ResourceWithConfigure      = &imageGenerationResource{}
+	_ resource.ResourceWithModifyPlan     = &imageGenerationResource{}
+	_ resource.ResourceWithValidateConfig = &imageGenerationResource{}
+)
+
+// imageModel describes the parameters supported by an image model.
+type imageModel struct {
+	sizes     []string
+	qualities []string
+	// gptImage is true for the GPT image models, which support background,
+	// output_format and output_compression and always return base64 images.
+	gptImage bool
+}
+
+// defaultImageModel is the model used by OpenAI when none is set.
+const defaultImageModel = "dall-e-2"
+
+// imageModels lists the parameters supported by the known image models.
+// Models missing from this list, e.g. behind an OpenAI compatible gateway,
+// are not validated.
+var imageModels = map[string]imageModel{
+	"dall-e-2": {
+		sizes:     []string{"256x256", "512x512", "1024x1024"},
+		qualities: []string{"standard"},
+	},
+	"dall-e-3": {
+		sizes:     []string{"1024x1024", "1792x1024", "1024x1792"},
+		qualities: []string{"standard", "hd"},
+	},
+	"gpt-image-1": {
+		sizes:     []string{"auto", "1024x1024", "1536x1024", "1024x1536"},
+		qualities: []string{"auto", "low", "medium", "high"},
+		gptImage:  true,
+	},
+	"gpt-image-1-mini": {
+		sizes:     []string{"auto", "1024x1024", "1536x1024", "1024x1536"},
+		qualities: []string{"auto", "low", "medium", "high"},
+		gptImage:  true,
+	},
+}
+
+// NewImageGenerationResource is a helper function to simplify the provider implementation.
+func NewImageGenerationResource() resource.Resource {
+	return &imageGenerationResource{}
+}
+
+// imageGenerationResource is the resource implementation.
+type imageGenerationResource struct {
+	client *openaiClient
+}
+
+// imageGenerationResourceModel maps the resource schema data.
+type imageGenerationResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Prompt        types.String   `tfsdk:"prompt"`
+	Model         types.String   `tfsdk:"model"`
+	Size          types.String   `tfsdk:"size"`
+	Quality       types.String   `tfsdk:"quality"`
+	Background    types.String   `tfsdk:"background"`
+	OutputFormat  types.String   `tfsdk:"output_format"`
+	Compression   types.Int64    `tfsdk:"output_compression"`
+	OutputPath    types.String   `tfsdk:"output_path"`
+	PromptSHA256  types.String   `tfsdk:"prompt_sha256"`
+	RevisedPrompt types.String   `tfsdk:"revised_prompt"`
+	OutputSHA256  types.String   `tfsdk:"output_sha256"`
+	B64JSON       types.String   `tfsdk:"b64_json"`
+	Project       types.String   `tfsdk:"project"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// imageGenerationRequest is the body of an image generation request.
+type imageGenerationRequest struct {
+	Prompt            string `json:"prompt"`
+	Model             string `json:"model,omitempty"`
+	Size              string `json:"size,omitempty"`
+	Quality           string `json:"quality,omitempty"`
+	Background        string `json:"background,omitempty"`
+	OutputFormat      string `json:"output_format,omitempty"`
+	OutputCompression *int64 `json:"output_compression,omitempty"`
+	N                 int    `json:"n"`
+	ResponseFormat    string `json:"response_format,omitempty"`
+}
+
+// imageResponse is the body of an image generation, edit or variation
+// response.
+type imageResponse struct {
+	Data []struct {
+		B64JSON       string `json:"b64_json"`
+		RevisedPrompt string `json:"revised_prompt"`
+	} `json:"data"`
+}
+
+// Metadata returns the resource type name.
+func (r *imageGenerationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_generation"
+}
+
+// Schema defines the schema for the resource.
+func (r *imageGenerationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates an image from a prompt. The image is written to `output_path` when set, and is always available base64 encoded in `b64_json`. The image is only generated again when one of the arguments changes, or when the file is removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "SHA-256 checksum of the generated image.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prompt": schema.StringAttribute{
+				Description: "Text description of the desired image.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Model used to generate the image, such as `dall-e-2`, `dall-e-3` or `gpt-image-1`. Defaults to `dall-e-2`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.StringAttribute{
+				MarkdownDescription: "Size of the generated image. `dall-e-2` supports `256x256`, `512x512` and `1024x1024`, `dall-e-3` supports `1024x1024`, `1792x1024` and `1024x1792`, GPT image models support `auto`, `1024x1024`, `1536x1024` and `1024x1536`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Quality of the generated image. `dall-e-2` supports `standard`, `dall-e-3` supports `standard` and `hd`, GPT image models support `auto`, `low`, `medium` and `high`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"background": schema.StringAttribute{
+				MarkdownDescription: "Background of the generated image, either `auto`, `transparent` or `opaque`. Only supported by GPT image models, a transparent background requires the `png` or `webp` output format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "transparent", "opaque"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_format": schema.StringAttribute{
+				MarkdownDescription: "Format of the generated image, either `png`, `jpeg` or `webp`. Only supported by GPT image models, other models always return `png` images.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("png", "jpeg", "webp"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_compression": schema.Int64Attribute{
+				MarkdownDescription: "Compression level of the generated image, from 0 to 100. Only supported by GPT image models with the `jpeg` or `webp` output format.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the file the image is written to. Leave unset to only use `b64_json`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prompt_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the prompt the image was generated from.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revised_prompt": schema.StringAttribute{
+				Description: "Prompt actually used to generate the image, when the model revised it.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the generated image.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"b64_json": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded content of the generated image, e.g. to upload it with `aws_s3_object.content_base64`. Marked as sensitive to keep megabytes of base64 out of the plan output, it is still stored in state.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the image is billed to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *imageGenerationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the model is available to the API key.
+func (r *imageGenerationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.modifyPlanModel(ctx, req, resp)
+}
+
+// ValidateConfig validates the image parameters against the model.
+func (r *imageGenerationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config imageGenerationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Model.IsUnknown() {
+		return
+	}
+
+	modelName := defaultImageModel
+	if !config.Model.IsNull() {
+		modelName = config.Model.ValueString()
+	}
+
+	model, ok := imageModels[modelName]
+	if !ok {
+		return
+	}
+
+	validateOneOf := func(attribute string, value types.String, allowed []string) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+		for _, v := range allowed {
+			if v == value.ValueString() {
+				return
+			}
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Unsupported "+strings.ReplaceAll(attribute, "_", " "),
+			fmt.Sprintf("%s %q is not supported by %s, supported values are: %s.", attribute, value.ValueString(), modelName, strings.Join(allowed, ", ")),
+		)
+	}
+
+	validateOneOf("size", config.Size, model.sizes)
+	validateOneOf("quality", config.Quality, model.qualities)
+
+	if !model.gptImage {
+		for _, attribute := range []struct {
+			name string
+			set  bool
+		}{
+			{"background", !config.Background.IsNull()},
+			{"output_format", !config.OutputFormat.IsNull()},
+			{"output_compression", !config.Compression.IsNull()},
+		} {
+			if attribute.set {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute.name),
+					"Unsupported "+strings.ReplaceAll(attribute.name, "_", " "),
+					fmt.Sprintf("%s is only supported by GPT image models, not by %s.", attribute.name, modelName),
+				)
+			}
+		}
+		return
+	}
+
+	if config.Background.ValueString() == "transparent" && config.OutputFormat.ValueString() == "jpeg" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("background"),
+			"Unsupported background",
+			"A transparent background requires the png or webp output format.",
+		)
+	}
+
+	if format := config.OutputFormat.ValueString(); !config.Compression.IsNull() && !config.OutputFormat.IsUnknown() && format != "jpeg" && format != "webp" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("output_compression"),
+			"Unsupported output compression",
+			"output_compression requires the jpeg or webp output format.",
+		)
+	}
+}
+
+// Create a new resource.
+func (r *imageGenerationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan imageGenerationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	imageRequest := imageGenerationRequest{
+		Prompt:            plan.Prompt.ValueString(),
+		Model:             plan.Model.ValueString(),
+		Size:              plan.Size.ValueString(),
+		Quality:           plan.Quality.ValueString(),
+		Background:        plan.Background.ValueString(),
+		OutputFormat:      plan.OutputFormat.ValueString(),
+		OutputCompression: plan.Compression.ValueInt64Pointer(),
+		N:                 1,
+	}
+
+	// GPT image models always return base64 images and reject response_format.
+	if !strings.HasPrefix(imageRequest.Model, "gpt-image-") {
+		imageRequest.ResponseFormat = "b64_json"
+	}
+
+	var image imageResponse
+	err := r.client.doJSON(ctx, http.MethodPost, "/images/generations", imageRequest, &image)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error generating image", "Could not generate image, unexpected error: ", err)
+		return
+	}
+
+	content, err := saveImage(image, plan.OutputPath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error saving image",
+			"Could not generate image, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	checksum := sha256Hex(content)
+	plan.ID = types.StringValue(checksum)
+	plan.PromptSHA256 = types.StringValue(sha256Hex([]byte(plan.Prompt.ValueString())))
+	plan.RevisedPrompt = types.StringValue(image.Data[0].RevisedPrompt)
+	plan.OutputSHA256 = types.StringValue(checksum)
+	plan.B64JSON = types.StringValue(image.Data[0].B64JSON)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *imageGenerationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state imageGenerationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate the image again when the file has been removed.
+	if !state.OutputPath.IsNull() && !localFileExists(state.OutputPath.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *imageGenerationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires a replacement, there is nothing to send to OpenAI.
+	var plan imageGenerationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *imageGenerationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state imageGenerationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.OutputPath.IsNull() {
+		return
+	}
+
+	if err := removeLocalFile(state.OutputPath.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting image file",
+			"Could not delete image file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// saveImage decodes the first image of the response and writes it to
+// outputPath, when set.
+func saveImage(image imageResponse, outputPath types.String) ([]byte, error) {
+	if len(image.Data) == 0 {
+		return nil, errors.New("the API returned no image")
+	}
+
+	content, err := base64.StdEncoding.DecodeString(image.Data[0].B64JSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if !outputPath.IsNull() {
+		if err := writeLocalFile(outputPath.ValueString(), content); err != nil {
+			return nil, err
+		}
+	}
+
+	return content, nil
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &imageVariationResource{}
+	_ resource.ResourceWithConfigure  = &imageVariationResource{}
+	_ resource.ResourceWithModifyPlan = &imageVariationResource{}
+)
+
+// NewImageVariationResource is a helper function to simplify the provider implementation.
+func NewImageVariationResource() resource.Resource {
+	return &imageVariationResource{}
+}
+
+// imageVariationResource is the resource implementation.
+type imageVariationResource struct {
+	client *openaiClient
+}
+
+// imageVariationResourceModel maps the resource schema data.
+type imageVariationResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	ImagePath    types.String   `tfsdk:"image_path"`
+	Model        types.String   `tfsdk:"model"`
+	Size         types.String   `tfsdk:"size"`
+	OutputPath   types.String   `tfsdk:"output_path"`
+	SourceSHA256 types.String   `tfsdk:"source_sha256"`
+	OutputSHA256 types.String   `tfsdk:"output_sha256"`
+	B64JSON      types.String   `tfsdk:"b64_json"`
+	Project      types.String   `tfsdk:"project"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *imageVariationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_variation"
+}
+
+// Schema defines the schema for the resource.
+func (r *imageVariationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a variation of a local image. The image is written to `output_path` when set, and is always available base64 encoded in `b64_json`. The variation is only created again when one of the arguments, or the content of the source image, changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "SHA-256 checksum of the image variation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_path": schema.StringAttribute{
+				Description: "Path to the square PNG image to create a variation of.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Model used to create the variation. Only `dall-e-2` is supported by OpenAI at this time.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.StringAttribute{
+				MarkdownDescription: "Size of the image variation, either `256x256`, `512x512` or `1024x1024`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("256x256", "512x512", "1024x1024"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the file the image variation is written to. Leave unset to only use `b64_json`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the source image. A change of the checksum creates the variation again.",
+				Computed:    true,
+			},
+			"output_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the image variation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"b64_json": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded content of the image variation. Marked as sensitive to keep megabytes of base64 out of the plan output, it is still stored in state.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the image variation is billed to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *imageVariationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the checksum of the source image, so a change of its
+// content creates the variation again, and checks the model is available to
+// the API key.
+func (r *imageVariationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.modifyPlanModel(ctx, req, resp)
+
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan imageVariationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.ImagePath.IsUnknown() {
+		return
+	}
+
+	modifyPlanLocalFilesChecksum(ctx, req, resp, path.Root("source_sha256"), plan.ImagePath.ValueString())
+}
+
+// Create a new resource.
+func (r *imageVariationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan imageVariationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	source, err := os.ReadFile(plan.ImagePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("image_path"),
+			"Error reading file content",
+			"Could not create image variation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var image imageResponse
+	err = r.client.doMultipart(ctx, "/images/variations", url.Values{
+		"model":           {plan.Model.ValueString()},
+		"size":            {plan.Size.ValueString()},
+		"n":               {"1"},
+		"response_format": {"b64_json"},
+	}, []multipartFile{
+		{field: "image", name: filepath.Base(plan.ImagePath.ValueString()), content: source},
+	}, &image)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error creating image variation", "Could not create image variation, unexpected error: ", err)
+		return
+	}
+
+	content, err := saveImage(image, plan.OutputPath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error saving image",
+			"Could not create image variation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	checksum := sha256Hex(content)
+	plan.ID = types.StringValue(checksum)
+	plan.SourceSHA256 = types.StringValue(sha256Hex(source))
+	plan.OutputSHA256 = types.StringValue(checksum)
+	plan.B64JSON = types.StringValue(image.Data[0].B64JSON)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *imageVariationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state imageVariationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the variation again when the file has been removed.
+	if !state.OutputPath.IsNull() && !localFileExists(state.OutputPath.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *imageVariationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires a replacement, there is nothing to send to OpenAI.
+	var plan imageVariationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *imageVariationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state imageVariationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.OutputPath.IsNull() {
+		return
+	}
+
+	if err := removeLocalFile(state.OutputPath.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting image file",
+			"Could not delete image file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -0,0 +1,14 @@
+package provider
+
+import "regexp"
+
+// Patterns of the OpenAI object IDs. Import IDs are matched against them
+// rather than parsed, so the ID formats of the other OpenAI providers are
+// accepted as well: a bare ID, an ID prefixed with its type such as
+// "assistants/asst_abc123", a URL of the OpenAI dashboard, or the IDs of a
+// compound import ID joined with "/", ":" or ",".
+var (
+	assistantIDPattern   = regexp.MustCompile(`\basst_[A-Za-z0-9]+`)
+	fileIDPattern        = regexp.MustCompile(`\bfile-[A-Za-z0-9]+`)
+	vectorStoreIDPattern = regexp.MustCompile(`\bvs_[A-Za-z0-9]+`)
+)
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestIDPatterns(t *testing.T) {
+	tests := map[string]struct {
+		pattern  *regexp.Regexp
+		importID string
+		expected string
+	}{
+		"assistant ID": {
+			pattern:  assistantIDPattern,
+			importID: "asst_abc123",
+			expected: "asst_abc123",
+		},
+		"assistant ID with type": {
+			pattern:  assistantIDPattern,
+			importID: "assistants/asst_abc123",
+			expected: "asst_abc123",
+		},
+		"assistant dashboard URL": {
+			pattern:  assistantIDPattern,
+			importID: "https://platform.openai.com/assistants/asst_abc123",
+			expected: "asst_abc123",
+		},
+		"assistant within a word": {
+			pattern:  assistantIDPattern,
+			importID: "xasst_abc123",
+		},
+		"assistant ID of another type": {
+			pattern:  assistantIDPattern,
+			importID: "file-abc123",
+		},
+		"assistant ID of a compound ID": {
+			pattern:  assistantIDPattern,
+			importID: "asst_abc123,file-def456",
+			expected: "asst_abc123",
+		},
+		"file ID": {
+			pattern:  fileIDPattern,
+			importID: "file-abc123",
+			expected: "file-abc123",
+		},
+		"file ID of a compound ID": {
+			pattern:  fileIDPattern,
+			importID: "asst_abc123/file-def456",
+			expected: "file-def456",
+		},
+		"file ID with a comma": {
+			pattern:  fileIDPattern,
+			importID: "asst_abc123,file-def456",
+			expected: "file-def456",
+		},
+		"file ID with a colon": {
+			pattern:  fileIDPattern,
+			importID: "asst_abc123:file-def456",
+			expected: "file-def456",
+		},
+		"vector store ID": {
+			pattern:  vectorStoreIDPattern,
+			importID: "vs_abc123",
+			expected: "vs_abc123",
+		},
+		"vector store ID with a comma": {
+			pattern:  vectorStoreIDPattern,
+			importID: "vs_abc123,file-def456",
+			expected: "vs_abc123",
+		},
+		"empty": {
+			pattern:  vectorStoreIDPattern,
+			importID: "",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if id := test.pattern.FindString(test.importID); id != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, id)
+			}
+		})
+	}
+}
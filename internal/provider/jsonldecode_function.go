@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &jsonldecodeFunction{}
+)
+
+// NewJSONLDecodeFunction is a helper function to simplify the provider implementation.
+func NewJSONLDecodeFunction() function.Function {
+	return &jsonldecodeFunction{}
+}
+
+// jsonldecodeFunction is the function implementation.
+type jsonldecodeFunction struct{}
+
+// Metadata returns the function name.
+func (f *jsonldecodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jsonldecode"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *jsonldecodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Decodes JSON Lines into JSON documents",
+		MarkdownDescription: "Splits a [JSON Lines](https://jsonlines.org/) content, such as the output file of a batch, into the list of its JSON documents, to decode each of them with `jsondecode`. The blank lines are skipped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "content",
+				Description: "JSON Lines content.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run decodes the content.
+func (f *jsonldecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &content)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	documents := []string{}
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var document bytes.Buffer
+		if err := json.Compact(&document, []byte(line)); err != nil {
+			resp.Diagnostics.AddArgumentError(0, "Invalid JSON Lines", fmt.Sprintf("Line %d is not valid JSON: %s", i+1, err))
+			return
+		}
+		documents = append(documents, document.String())
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, documents)...)
+}
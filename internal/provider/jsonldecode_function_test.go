@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONLDecodeFunction(t *testing.T) {
+	content := `{"custom_id": "request-1", "response": {"status_code": 200}}` + "\r\n\n" + `{"custom_id":"request-2"}` + "\n"
+
+	result, diags := runFunction(t, NewJSONLDecodeFunction(), types.StringValue(content))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var documents []string
+	if diags := result.(types.List).ElementsAs(context.Background(), &documents, false); diags.HasError() {
+		t.Fatal(diags)
+	}
+	expected := []string{`{"custom_id":"request-1","response":{"status_code":200}}`, `{"custom_id":"request-2"}`}
+	if strings.Join(documents, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected %q, got %q", expected, documents)
+	}
+
+	_, diags = runFunction(t, NewJSONLDecodeFunction(), types.StringValue(`{"custom_id":"request-1"}`+"\n"+`{"custom_id":`))
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "Line 2") {
+		t.Errorf("expected an error on line 2, got %v", diags)
+	}
+}
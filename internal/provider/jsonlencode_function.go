@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &jsonlencodeFunction{}
+)
+
+// NewJSONLEncodeFunction is a helper function to simplify the provider implementation.
+func NewJSONLEncodeFunction() function.Function {
+	return &jsonlencodeFunction{}
+}
+
+// jsonlencodeFunction is the function implementation.
+type jsonlencodeFunction struct{}
+
+// Metadata returns the function name.
+func (f *jsonlencodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jsonlencode"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *jsonlencodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Encodes JSON documents as JSON Lines",
+		MarkdownDescription: "Encodes a list of JSON documents, each encoded with `jsonencode`, as [JSON Lines](https://jsonlines.org/): one compact document per line, such as the input files of batches and fine-tuning jobs.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "documents",
+				ElementType:         types.StringType,
+				MarkdownDescription: "JSON documents, e.g. `[for request in local.requests : jsonencode(request)]`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run encodes the documents.
+func (f *jsonlencodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var documents []string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &documents)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var content strings.Builder
+	for i, document := range documents {
+		var line bytes.Buffer
+		if err := json.Compact(&line, []byte(document)); err != nil {
+			resp.Diagnostics.AddArgumentError(0, "Invalid JSON document", fmt.Sprintf("The document at index %d is not valid JSON: %s", i, err))
+			return
+		}
+		content.Write(line.Bytes())
+		content.WriteByte('\n')
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, content.String())...)
+}
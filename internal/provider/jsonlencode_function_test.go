@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONLEncodeFunction(t *testing.T) {
+	documents := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue(`{"custom_id": "request-1",
+			"method": "POST"}`),
+		types.StringValue(`{"custom_id":"request-2","method":"POST"}`),
+	})
+
+	result, diags := runFunction(t, NewJSONLEncodeFunction(), documents)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := `{"custom_id":"request-1","method":"POST"}` + "\n" + `{"custom_id":"request-2","method":"POST"}` + "\n"
+	if !result.Equal(types.StringValue(expected)) {
+		t.Errorf("expected %q, got %s", expected, result)
+	}
+
+	invalid := types.ListValueMust(types.StringType, []attr.Value{types.StringValue(`{"custom_id":`)})
+	if _, diags := runFunction(t, NewJSONLEncodeFunction(), invalid); !diags.HasError() {
+		t.Error("expected an error for an invalid document")
+	}
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sha256Hex returns the hex encoded SHA-256 checksum of content.
+func sha256Hex(content []byte) string {
+	checksum := sha256.Sum256(content)
+	return hex.EncodeToString(checksum[:])
+}
+
+// readerSHA256 returns the hex encoded SHA-256 checksum of the content read
+// from r, streamed rather than read in memory.
+func readerSHA256(r io.Reader) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// localFilesSHA256 returns the SHA-256 checksum of the content of the files.
+// The checksum of a single file is the checksum of its content.
+func localFilesSHA256(paths ...string) (string, error) {
+	hash := sha256.New()
+
+	for _, p := range paths {
+		checksum, err := localFileSHA256(p)
+		if err != nil {
+			return "", err
+		}
+		if len(paths) == 1 {
+			return checksum, nil
+		}
+		hash.Write([]byte(checksum))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// localFileSHA256 returns the SHA-256 checksum of the content of the file at
+// path.
+func localFileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return readerSHA256(file)
+}
+
+// openLocalFile opens the file at path to stream its content, e.g. to upload
+// it. The file must be closed once read.
+func openLocalFile(path string) (*os.File, *io.SectionReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+
+	return file, io.NewSectionReader(file, 0, info.Size()), nil
+}
+
+// modifyPlanLocalFilesChecksum sets the checksum attribute of the plan to the
+// checksum of the local files, and requires a replacement of the resource
+// when it differs from the checksum in state. The previous checksum is kept
+// when the files cannot be read yet, e.g. when they are generated by another
+// resource during apply.
+func modifyPlanLocalFilesChecksum(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attribute path.Path, paths ...string) {
+	var previous types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attribute, &previous)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	checksum, err := localFilesSHA256(paths...)
+	if err != nil {
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attribute, previous)...)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attribute, checksum)...)
+
+	if !req.State.Raw.IsNull() && previous.ValueString() != checksum {
+		resp.RequiresReplace = append(resp.RequiresReplace, attribute)
+	}
+}
+
+// fileSources are the attributes of the openai_file resource uploading a file,
+// either from the local filesystem, from a URL, or from an inline content.
+type fileSources struct {
+	Path          types.String
+	URL           types.String
+	Content       types.String
+	ContentBase64 types.String
+	// SHA256 is the configured checksum of the content, only allowed for the
+	// files downloaded from a URL.
+	SHA256 types.String
+}
+
+// validate ensures exactly one of the sources of the file is set, that the
+// base64 encoded content is valid, and that the checksum is only set for the
+// files downloaded from a URL.
+func (f fileSources) validate(diags *diag.Diagnostics) {
+	set := 0
+	for _, source := range []types.String{f.Path, f.URL, f.Content, f.ContentBase64} {
+		if !source.IsNull() {
+			set++
+		}
+	}
+
+	if set > 1 {
+		diags.AddError(
+			"Conflicting file source",
+			"Only one of source_path, source_url, content or content_base64 can be set.",
+		)
+	}
+
+	if set == 0 {
+		diags.AddError(
+			"Missing file source",
+			"One of source_path, source_url, content or content_base64 must be set.",
+		)
+	}
+
+	if !f.ContentBase64.IsNull() && !f.ContentBase64.IsUnknown() {
+		if _, err := base64.StdEncoding.DecodeString(f.ContentBase64.ValueString()); err != nil {
+			diags.AddAttributeError(
+				path.Root("content_base64"),
+				"Invalid base64 content",
+				"content_base64 must be base64 encoded, e.g. with the base64encode or filebase64 functions: "+err.Error(),
+			)
+		}
+	}
+
+	if !f.SHA256.IsNull() && f.URL.IsNull() {
+		diags.AddAttributeError(
+			path.Root("source_sha256"),
+			"Unexpected checksum",
+			"source_sha256 can only be set with source_url, it is computed from the other sources of the file.",
+		)
+	}
+}
+
+// inlineFileContent returns the inline content of a file, either its content
+// or its decoded base64 encoded content. The content is empty when neither is
+// set.
+func inlineFileContent(content, contentBase64 types.String) ([]byte, error) {
+	if !contentBase64.IsNull() {
+		return base64.StdEncoding.DecodeString(contentBase64.ValueString())
+	}
+
+	return []byte(content.ValueString()), nil
+}
+
+// writeLocalFile writes content to path, creating the parent directories when
+// they do not exist.
+func writeLocalFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0644)
+}
+
+// removeLocalFile removes the file at path. A file that does not exist is not
+// an error.
+func removeLocalFile(path string) error {
+	err := os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// localFileExists reports whether a file exists at path.
+func localFileExists(path string) bool {
+	_, err := os.Stat(path)
+	return !errors.Is(err, os.ErrNotExist)
+}
@@ -0,0 +1,808 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// mockOpenAI is an in-memory mock of the endpoints of the OpenAI API used by
+// the assistant, file, vector store, thread, conversation, container and chat
+// completion resources, and by the model data source, so their tests run
+// without an OpenAI account. The objects are kept until the test ends.
+type mockOpenAI struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	nextID        int
+	models        []string
+	assistants    map[string]assistantObject
+	files         map[string]mockFile
+	vectorStores  map[string]mockVectorStore
+	threads       map[string]threadObject
+	conversations map[string]conversationObject
+	containers    map[string]containerObject
+	// requests are the requests received, as "METHOD /path".
+	requests []string
+}
+
+// mockFile is a file uploaded to the mock, with its content.
+type mockFile struct {
+	fileObject
+	content []byte
+}
+
+// mockVectorStore is a vector store of the mock, with the IDs of its files.
+type mockVectorStore struct {
+	vectorStoreObject
+	fileIDs []string
+}
+
+// newMockOpenAI starts a mock of the OpenAI API, closed when the test ends.
+func newMockOpenAI(t *testing.T) *mockOpenAI {
+	t.Helper()
+
+	m := &mockOpenAI{
+		models:        []string{"gpt-4o", "gpt-4o-mini", "o3"},
+		assistants:    map[string]assistantObject{},
+		files:         map[string]mockFile{},
+		vectorStores:  map[string]mockVectorStore{},
+		threads:       map[string]threadObject{},
+		conversations: map[string]conversationObject{},
+		containers:    map[string]containerObject{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /models", m.listModels)
+	mux.HandleFunc("GET /models/{id}", m.getModel)
+	mux.HandleFunc("POST /chat/completions", m.createChatCompletion)
+	mux.HandleFunc("POST /assistants", m.createAssistant)
+	mux.HandleFunc("GET /assistants", m.listAssistants)
+	mux.HandleFunc("GET /assistants/{id}", m.getAssistant)
+	mux.HandleFunc("POST /assistants/{id}", m.updateAssistant)
+	mux.HandleFunc("DELETE /assistants/{id}", m.deleteAssistant)
+	mux.HandleFunc("POST /files", m.createFile)
+	mux.HandleFunc("GET /files", m.listFiles)
+	mux.HandleFunc("GET /files/{id}", m.getFile)
+	mux.HandleFunc("GET /files/{id}/content", m.getFileContent)
+	mux.HandleFunc("DELETE /files/{id}", m.deleteFile)
+	mux.HandleFunc("POST /vector_stores", m.createVectorStore)
+	mux.HandleFunc("GET /vector_stores/{id}", m.getVectorStore)
+	mux.HandleFunc("POST /vector_stores/{id}", m.updateVectorStore)
+	mux.HandleFunc("DELETE /vector_stores/{id}", m.deleteVectorStore)
+	mux.HandleFunc("GET /vector_stores/{id}/files", m.listVectorStoreFiles)
+	mux.HandleFunc("POST /vector_stores/{id}/files", m.addVectorStoreFile)
+	mux.HandleFunc("DELETE /vector_stores/{id}/files/{file_id}", m.removeVectorStoreFile)
+	mux.HandleFunc("POST /threads", m.createThread)
+	mux.HandleFunc("GET /threads/{id}", m.getThread)
+	mux.HandleFunc("POST /threads/{id}", m.updateThread)
+	mux.HandleFunc("DELETE /threads/{id}", m.deleteThread)
+	mux.HandleFunc("POST /conversations", m.createConversation)
+	mux.HandleFunc("GET /conversations/{id}", m.getConversation)
+	mux.HandleFunc("POST /conversations/{id}", m.updateConversation)
+	mux.HandleFunc("DELETE /conversations/{id}", m.deleteConversation)
+	mux.HandleFunc("POST /containers", m.createContainer)
+	mux.HandleFunc("GET /containers/{id}", m.getContainer)
+	mux.HandleFunc("DELETE /containers/{id}", m.deleteContainer)
+
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		m.requests = append(m.requests, r.Method+" "+r.URL.Path)
+		m.mu.Unlock()
+
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			writeMockError(w, http.StatusUnauthorized, "Missing API key.")
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+// client returns a client of the provider sending its requests to the mock.
+func (m *mockOpenAI) client() *openaiClient {
+	return newOpenAIClient(openaiClientOptions{
+		APIKey:            "sk-test",
+		BaseURL:           m.URL,
+		UploadPartSize:    defaultUploadPartSize,
+		UploadConcurrency: defaultUploadConcurrency,
+	})
+}
+
+// id returns a new ID with the prefix of the type of the object.
+func (m *mockOpenAI) id(prefix string) string {
+	m.nextID++
+	return fmt.Sprintf("%smock%04d", prefix, m.nextID)
+}
+
+// received returns the number of requests received as "METHOD /path".
+func (m *mockOpenAI) received(request string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	count := 0
+	for _, received := range m.requests {
+		if received == request {
+			count++
+		}
+	}
+
+	return count
+}
+
+// writeMockJSON writes v as a JSON response.
+func writeMockJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeMockError writes an error response in the format of the OpenAI API.
+func writeMockError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{
+			"message": message,
+			"type":    "invalid_request_error",
+		},
+	})
+}
+
+// mockPage returns the page of the sorted objects selected by the after and
+// limit query parameters of the request, and whether more objects follow.
+func mockPage[T any](r *http.Request, objects []T, id func(T) string) ([]T, bool) {
+	if after := r.URL.Query().Get("after"); after != "" {
+		for i, object := range objects {
+			if id(object) == after {
+				objects = objects[i+1:]
+				break
+			}
+		}
+	}
+
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	if len(objects) > limit {
+		return objects[:limit], true
+	}
+
+	return objects, false
+}
+
+func (m *mockOpenAI) listModels(w http.ResponseWriter, _ *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var models []modelObject
+	for _, id := range m.models {
+		models = append(models, modelObject{ID: id, OwnedBy: "openai"})
+	}
+	writeMockJSON(w, map[string]any{"object": "list", "data": models})
+}
+
+func (m *mockOpenAI) createAssistant(w http.ResponseWriter, r *http.Request) {
+	var request assistantRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// OpenAI reports the default sampling parameters.
+	defaultSampling := 1.0
+	assistant := assistantObject{
+		ID:          m.id("asst_"),
+		Temperature: &defaultSampling,
+		TopP:        &defaultSampling,
+		CreatedAt:   time.Now().Unix(),
+	}
+	applyMockAssistantRequest(&assistant, request)
+	m.assistants[assistant.ID] = assistant
+
+	writeMockJSON(w, assistant)
+}
+
+func (m *mockOpenAI) listAssistants(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	assistants := []assistantObject{}
+	for _, assistant := range m.assistants {
+		assistants = append(assistants, assistant)
+	}
+	sort.Slice(assistants, func(i, j int) bool {
+		return assistants[i].ID > assistants[j].ID
+	})
+
+	assistants, hasMore := mockPage(r, assistants, func(assistant assistantObject) string { return assistant.ID })
+	writeMockJSON(w, map[string]any{"object": "list", "data": assistants, "has_more": hasMore})
+}
+
+func (m *mockOpenAI) getAssistant(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	assistant, ok := m.assistants[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No assistant found with id '"+r.PathValue("id")+"'.")
+		return
+	}
+
+	writeMockJSON(w, assistant)
+}
+
+func (m *mockOpenAI) updateAssistant(w http.ResponseWriter, r *http.Request) {
+	var request assistantRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	assistant, ok := m.assistants[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No assistant found with id '"+r.PathValue("id")+"'.")
+		return
+	}
+	applyMockAssistantRequest(&assistant, request)
+	m.assistants[assistant.ID] = assistant
+
+	writeMockJSON(w, assistant)
+}
+
+// applyMockAssistantRequest sets the fields of a request creating or updating
+// an assistant, the fields not sent are left unchanged.
+func applyMockAssistantRequest(assistant *assistantObject, request assistantRequest) {
+	if request.Name != nil {
+		assistant.Name = *request.Name
+	}
+	if request.Description != nil {
+		assistant.Description = request.Description
+	}
+	if request.Model != "" {
+		assistant.Model = request.Model
+	}
+	if request.Instructions != nil {
+		assistant.Instructions = *request.Instructions
+	}
+	if request.Temperature != nil {
+		assistant.Temperature = request.Temperature
+	}
+	if request.TopP != nil {
+		assistant.TopP = request.TopP
+	}
+	if request.Tools != nil {
+		assistant.Tools = request.Tools
+	}
+	if request.ToolResources != nil {
+		assistant.ToolResources = request.ToolResources
+	}
+	if request.Metadata != nil {
+		assistant.Metadata = request.Metadata
+	}
+}
+
+func (m *mockOpenAI) deleteAssistant(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := m.assistants[id]; !ok {
+		writeMockError(w, http.StatusNotFound, "No assistant found with id '"+id+"'.")
+		return
+	}
+	delete(m.assistants, id)
+
+	writeMockJSON(w, map[string]any{"id": id, "object": "assistant.deleted", "deleted": true})
+}
+
+func (m *mockOpenAI) createFile(w http.ResponseWriter, r *http.Request) {
+	upload, header, err := r.FormFile("file")
+	if err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	defer upload.Close()
+
+	content, err := io.ReadAll(upload)
+	if err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file := mockFile{
+		fileObject: fileObject{
+			ID:        m.id("file-"),
+			Filename:  header.Filename,
+			Purpose:   r.FormValue("purpose"),
+			Bytes:     int64(len(content)),
+			CreatedAt: time.Now().Unix(),
+			Status:    "processed",
+		},
+		content: content,
+	}
+	m.files[file.ID] = file
+
+	writeMockJSON(w, file.fileObject)
+}
+
+func (m *mockOpenAI) listFiles(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	files := []fileObject{}
+	for _, file := range m.files {
+		files = append(files, file.fileObject)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ID < files[j].ID
+	})
+
+	files, hasMore := mockPage(r, files, func(file fileObject) string { return file.ID })
+	writeMockJSON(w, map[string]any{"object": "list", "data": files, "has_more": hasMore})
+}
+
+func (m *mockOpenAI) getFile(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, ok := m.files[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No such File object: "+r.PathValue("id"))
+		return
+	}
+
+	writeMockJSON(w, file.fileObject)
+}
+
+func (m *mockOpenAI) getFileContent(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, ok := m.files[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No such File object: "+r.PathValue("id"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write(file.content)
+}
+
+func (m *mockOpenAI) deleteFile(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := m.files[id]; !ok {
+		writeMockError(w, http.StatusNotFound, "No such File object: "+id)
+		return
+	}
+	delete(m.files, id)
+
+	writeMockJSON(w, map[string]any{"id": id, "object": "file", "deleted": true})
+}
+
+func (m *mockOpenAI) getModel(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := r.PathValue("id")
+	if !slices.Contains(m.models, id) {
+		writeMockError(w, http.StatusNotFound, "The model '"+id+"' does not exist")
+		return
+	}
+
+	writeMockJSON(w, modelObject{ID: id, Created: 1715367049, OwnedBy: "openai"})
+}
+
+func (m *mockOpenAI) createChatCompletion(w http.ResponseWriter, r *http.Request) {
+	var request chatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !slices.Contains(m.models, request.Model) {
+		writeMockError(w, http.StatusNotFound, "The model '"+request.Model+"' does not exist")
+		return
+	}
+
+	// The mock answers with the content of the last message.
+	content := ""
+	if len(request.Messages) > 0 {
+		content = request.Messages[len(request.Messages)-1].Content
+	}
+	writeMockJSON(w, map[string]any{
+		"id":                 m.id("chatcmpl-"),
+		"object":             "chat.completion",
+		"model":              request.Model,
+		"system_fingerprint": "fp_mock",
+		"choices": []map[string]any{{
+			"index":         0,
+			"message":       map[string]any{"role": "assistant", "content": content},
+			"finish_reason": "stop",
+		}},
+	})
+}
+
+func (m *mockOpenAI) createVectorStore(w http.ResponseWriter, r *http.Request) {
+	var request vectorStoreRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	store := mockVectorStore{
+		vectorStoreObject: vectorStoreObject{
+			ID:        m.id("vs_"),
+			Status:    "completed",
+			CreatedAt: time.Now().Unix(),
+		},
+		fileIDs: request.FileIDs,
+	}
+	applyMockVectorStoreRequest(&store, request)
+	m.vectorStores[store.ID] = store
+
+	writeMockJSON(w, m.vectorStoreObject(store))
+}
+
+func (m *mockOpenAI) getVectorStore(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	store, ok := m.vectorStores[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No vector store found with id '"+r.PathValue("id")+"'.")
+		return
+	}
+
+	writeMockJSON(w, m.vectorStoreObject(store))
+}
+
+func (m *mockOpenAI) updateVectorStore(w http.ResponseWriter, r *http.Request) {
+	var request vectorStoreRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	store, ok := m.vectorStores[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No vector store found with id '"+r.PathValue("id")+"'.")
+		return
+	}
+	applyMockVectorStoreRequest(&store, request)
+	m.vectorStores[store.ID] = store
+
+	writeMockJSON(w, m.vectorStoreObject(store))
+}
+
+// applyMockVectorStoreRequest sets the fields of a request creating or
+// updating a vector store, the name is left unchanged when not sent.
+func applyMockVectorStoreRequest(store *mockVectorStore, request vectorStoreRequest) {
+	if request.Name != nil {
+		store.Name = *request.Name
+	}
+	store.ExpiresAfter = request.ExpiresAfter
+	store.Metadata = request.Metadata
+}
+
+// vectorStoreObject returns the vector store with the counts of its files,
+// the files of the mock are processed at once.
+func (m *mockOpenAI) vectorStoreObject(store mockVectorStore) vectorStoreObject {
+	object := store.vectorStoreObject
+	for _, fileID := range store.fileIDs {
+		if file, ok := m.files[fileID]; ok {
+			object.UsageBytes += file.Bytes
+			object.FileCounts.Completed++
+		} else {
+			object.FileCounts.Failed++
+		}
+		object.FileCounts.Total++
+	}
+
+	return object
+}
+
+func (m *mockOpenAI) deleteVectorStore(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := m.vectorStores[id]; !ok {
+		writeMockError(w, http.StatusNotFound, "No vector store found with id '"+id+"'.")
+		return
+	}
+	delete(m.vectorStores, id)
+
+	writeMockJSON(w, map[string]any{"id": id, "object": "vector_store.deleted", "deleted": true})
+}
+
+func (m *mockOpenAI) listVectorStoreFiles(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	store, ok := m.vectorStores[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No vector store found with id '"+r.PathValue("id")+"'.")
+		return
+	}
+
+	files, hasMore := mockPage(r, slices.Clone(store.fileIDs), func(id string) string { return id })
+	data := []map[string]any{}
+	for _, id := range files {
+		data = append(data, map[string]any{"id": id, "object": "vector_store.file", "vector_store_id": store.ID})
+	}
+	lastID := ""
+	if len(files) > 0 {
+		lastID = files[len(files)-1]
+	}
+
+	writeMockJSON(w, map[string]any{"object": "list", "data": data, "has_more": hasMore, "last_id": lastID})
+}
+
+func (m *mockOpenAI) addVectorStoreFile(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		FileID string `json:"file_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	store, ok := m.vectorStores[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No vector store found with id '"+r.PathValue("id")+"'.")
+		return
+	}
+	if !slices.Contains(store.fileIDs, request.FileID) {
+		store.fileIDs = append(store.fileIDs, request.FileID)
+	}
+	m.vectorStores[store.ID] = store
+
+	writeMockJSON(w, map[string]any{"id": request.FileID, "object": "vector_store.file", "vector_store_id": store.ID, "status": "completed"})
+}
+
+func (m *mockOpenAI) removeVectorStoreFile(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	store, ok := m.vectorStores[r.PathValue("id")]
+	fileID := r.PathValue("file_id")
+	if !ok || !slices.Contains(store.fileIDs, fileID) {
+		writeMockError(w, http.StatusNotFound, "No file found with id '"+fileID+"' in vector store '"+r.PathValue("id")+"'.")
+		return
+	}
+	store.fileIDs = slices.DeleteFunc(slices.Clone(store.fileIDs), func(id string) bool { return id == fileID })
+	m.vectorStores[store.ID] = store
+
+	writeMockJSON(w, map[string]any{"id": fileID, "object": "vector_store.file.deleted", "deleted": true})
+}
+
+func (m *mockOpenAI) createThread(w http.ResponseWriter, r *http.Request) {
+	var request threadRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	thread := threadObject{
+		ID:            m.id("thread_"),
+		CreatedAt:     time.Now().Unix(),
+		ToolResources: request.ToolResources,
+		Metadata:      request.Metadata,
+	}
+	m.threads[thread.ID] = thread
+
+	writeMockJSON(w, thread)
+}
+
+func (m *mockOpenAI) getThread(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	thread, ok := m.threads[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No thread found with id '"+r.PathValue("id")+"'.")
+		return
+	}
+
+	writeMockJSON(w, thread)
+}
+
+func (m *mockOpenAI) updateThread(w http.ResponseWriter, r *http.Request) {
+	var request threadRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	thread, ok := m.threads[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "No thread found with id '"+r.PathValue("id")+"'.")
+		return
+	}
+	if request.ToolResources != nil {
+		thread.ToolResources = request.ToolResources
+	}
+	thread.Metadata = request.Metadata
+	m.threads[thread.ID] = thread
+
+	writeMockJSON(w, thread)
+}
+
+func (m *mockOpenAI) deleteThread(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := m.threads[id]; !ok {
+		writeMockError(w, http.StatusNotFound, "No thread found with id '"+id+"'.")
+		return
+	}
+	delete(m.threads, id)
+
+	writeMockJSON(w, map[string]any{"id": id, "object": "thread.deleted", "deleted": true})
+}
+
+func (m *mockOpenAI) createConversation(w http.ResponseWriter, r *http.Request) {
+	var request conversationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	conversation := conversationObject{
+		ID:        m.id("conv_"),
+		CreatedAt: time.Now().Unix(),
+		Metadata:  request.Metadata,
+	}
+	m.conversations[conversation.ID] = conversation
+
+	writeMockJSON(w, conversation)
+}
+
+func (m *mockOpenAI) getConversation(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	conversation, ok := m.conversations[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "Conversation with id '"+r.PathValue("id")+"' not found.")
+		return
+	}
+
+	writeMockJSON(w, conversation)
+}
+
+func (m *mockOpenAI) updateConversation(w http.ResponseWriter, r *http.Request) {
+	var request conversationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	conversation, ok := m.conversations[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "Conversation with id '"+r.PathValue("id")+"' not found.")
+		return
+	}
+	conversation.Metadata = request.Metadata
+	m.conversations[conversation.ID] = conversation
+
+	writeMockJSON(w, conversation)
+}
+
+func (m *mockOpenAI) deleteConversation(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := m.conversations[id]; !ok {
+		writeMockError(w, http.StatusNotFound, "Conversation with id '"+id+"' not found.")
+		return
+	}
+	delete(m.conversations, id)
+
+	writeMockJSON(w, map[string]any{"id": id, "object": "conversation.deleted", "deleted": true})
+}
+
+func (m *mockOpenAI) createContainer(w http.ResponseWriter, r *http.Request) {
+	var request containerRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeMockError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// OpenAI reports the default expiration policy.
+	expiresAfter := request.ExpiresAfter
+	if expiresAfter == nil {
+		expiresAfter = &containerExpiresAfter{Anchor: "last_active_at", Minutes: 20}
+	}
+	container := containerObject{
+		ID:           m.id("cntr_"),
+		Name:         request.Name,
+		Status:       "running",
+		CreatedAt:    time.Now().Unix(),
+		ExpiresAfter: expiresAfter,
+	}
+	m.containers[container.ID] = container
+
+	writeMockJSON(w, container)
+}
+
+func (m *mockOpenAI) getContainer(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	container, ok := m.containers[r.PathValue("id")]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, "Container not found")
+		return
+	}
+
+	writeMockJSON(w, container)
+}
+
+func (m *mockOpenAI) deleteContainer(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := m.containers[id]; !ok {
+		writeMockError(w, http.StatusNotFound, "Container not found")
+		return
+	}
+	delete(m.containers, id)
+
+	writeMockJSON(w, map[string]any{"id": id, "object": "container.deleted", "deleted": true})
+}
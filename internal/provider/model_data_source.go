@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &modelDataSource{}
+	_ datasource.DataSourceWithConfigure = &modelDataSource{}
+)
+
+// NewModelDataSource is a helper function to simplify the provider implementation.
+func NewModelDataSource() datasource.DataSource {
+	return &modelDataSource{}
+}
+
+// modelDataSource is the data source implementation.
+type modelDataSource struct {
+	client *openaiClient
+}
+
+// modelDataSourceModel maps the data source schema data.
+type modelDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	OwnedBy   types.String `tfsdk:"owned_by"`
+	Created   types.Int64  `tfsdk:"created"`
+	FineTuned types.Bool   `tfsdk:"fine_tuned"`
+	BaseModel types.String `tfsdk:"base_model"`
+}
+
+// modelObject is a model returned by the Models API.
+type modelObject struct {
+	ID      string `json:"id"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+}
+
+// Metadata returns the data source type name.
+func (d *modelDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model"
+}
+
+// Schema defines the schema for the data source.
+func (d *modelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches an OpenAI model. Reading a model that does not exist, or is not available to the API key, fails the plan, so the models referenced by the configuration can be checked before creating the resources using them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the model, e.g. `gpt-4o` or `ft:gpt-4o-mini-2024-07-18:my-org::abc123`.",
+				Required:            true,
+			},
+			"owned_by": schema.StringAttribute{
+				MarkdownDescription: "Organization owning the model, such as `openai`, `system` or the organization of a fine-tuned model.",
+				Computed:            true,
+			},
+			"created": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the model.",
+				Computed:    true,
+			},
+			"fine_tuned": schema.BoolAttribute{
+				Description: "Whether the model is a fine-tuned model.",
+				Computed:    true,
+			},
+			"base_model": schema.StringAttribute{
+				Description: "ID of the model the model was fine-tuned from. Empty when the model is not fine-tuned.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *modelDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *modelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var model modelObject
+	err := d.client.doJSON(ctx, http.MethodGet, "/models/"+data.ID.ValueString(), nil, &model)
+	if errorStatusCode(err) == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Model not found",
+			fmt.Sprintf("The model %q does not exist, or is not available to the API key: %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to read OpenAI model", "", err)
+		return
+	}
+
+	data.ID = types.StringValue(model.ID)
+	data.OwnedBy = types.StringValue(model.OwnedBy)
+	data.Created = types.Int64Value(model.Created)
+
+	// The IDs of the fine-tuned models are formatted as
+	// ft:<base model>:<organization>:<suffix>:<job ID>.
+	baseModel := ""
+	if parts := strings.Split(model.ID, ":"); len(parts) > 1 && parts[0] == "ft" {
+		baseModel = parts[1]
+	}
+	data.FineTuned = types.BoolValue(baseModel != "")
+	data.BaseModel = types.StringValue(baseModel)
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccModelDataSource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "openai_model" "test" {
+  id = "gpt-4o"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_model.test", "id", "gpt-4o"),
+					resource.TestCheckResourceAttrSet("data.openai_model.test", "owned_by"),
+				),
+			},
+			{
+				Config: `
+data "openai_model" "test" {
+  id = "gpt-does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`gpt-does-not-exist`),
+			},
+		},
+	})
+}
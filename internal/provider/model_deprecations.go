@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// modelShutdownNotice is how long before the shutdown of a deprecated model
+// the plans using it start warning about it.
+const modelShutdownNotice = 90 * 24 * time.Hour
+
+// modelDeprecation is the shutdown of a deprecated model.
+type modelDeprecation struct {
+	// shutdown is the date, formatted as 2006-01-02, from which the model is
+	// no longer available.
+	shutdown string
+	// replacement is the model recommended by OpenAI instead.
+	replacement string
+}
+
+// modelDeprecations are the deprecated models announced by OpenAI, see
+// https://platform.openai.com/docs/deprecations. The table ships with the
+// provider so the plans warn about them without calling the API.
+var modelDeprecations = map[string]modelDeprecation{
+	"ada":                        {shutdown: "2024-01-04", replacement: "babbage-002"},
+	"babbage":                    {shutdown: "2024-01-04", replacement: "babbage-002"},
+	"curie":                      {shutdown: "2024-01-04", replacement: "davinci-002"},
+	"davinci":                    {shutdown: "2024-01-04", replacement: "davinci-002"},
+	"text-ada-001":               {shutdown: "2024-01-04", replacement: "gpt-3.5-turbo-instruct"},
+	"text-babbage-001":           {shutdown: "2024-01-04", replacement: "gpt-3.5-turbo-instruct"},
+	"text-curie-001":             {shutdown: "2024-01-04", replacement: "gpt-3.5-turbo-instruct"},
+	"text-davinci-002":           {shutdown: "2024-01-04", replacement: "gpt-3.5-turbo-instruct"},
+	"text-davinci-003":           {shutdown: "2024-01-04", replacement: "gpt-3.5-turbo-instruct"},
+	"text-davinci-edit-001":      {shutdown: "2024-01-04", replacement: "gpt-4o"},
+	"code-davinci-edit-001":      {shutdown: "2024-01-04", replacement: "gpt-4o"},
+	"text-similarity-ada-001":    {shutdown: "2024-01-04", replacement: "text-embedding-3-small"},
+	"text-search-ada-doc-001":    {shutdown: "2024-01-04", replacement: "text-embedding-3-small"},
+	"text-search-ada-query-001":  {shutdown: "2024-01-04", replacement: "text-embedding-3-small"},
+	"gpt-4-0314":                 {shutdown: "2024-06-13", replacement: "gpt-4o"},
+	"gpt-4-32k-0314":             {shutdown: "2024-06-13", replacement: "gpt-4o"},
+	"gpt-3.5-turbo-0301":         {shutdown: "2024-09-13", replacement: "gpt-4o-mini"},
+	"gpt-3.5-turbo-0613":         {shutdown: "2024-09-13", replacement: "gpt-4o-mini"},
+	"gpt-3.5-turbo-16k-0613":     {shutdown: "2024-09-13", replacement: "gpt-4o-mini"},
+	"gpt-4-vision-preview":       {shutdown: "2024-12-06", replacement: "gpt-4o"},
+	"gpt-4-1106-vision-preview":  {shutdown: "2024-12-06", replacement: "gpt-4o"},
+	"gpt-4-32k":                  {shutdown: "2025-06-06", replacement: "gpt-4o"},
+	"gpt-4-32k-0613":             {shutdown: "2025-06-06", replacement: "gpt-4o"},
+	"gpt-4.5-preview":            {shutdown: "2025-07-14", replacement: "gpt-4.1"},
+	"gpt-4.5-preview-2025-02-27": {shutdown: "2025-07-14", replacement: "gpt-4.1"},
+	"o1-preview":                 {shutdown: "2025-07-28", replacement: "o3"},
+	"o1-preview-2024-09-12":      {shutdown: "2025-07-28", replacement: "o3"},
+	"o1-mini":                    {shutdown: "2025-10-27", replacement: "o4-mini"},
+	"o1-mini-2024-09-12":         {shutdown: "2025-10-27", replacement: "o4-mini"},
+}
+
+// addModelDeprecationWarning adds a warning to diags when the model is shut
+// down, or is shut down within modelShutdownNotice of now.
+func addModelDeprecationWarning(diags *diag.Diagnostics, attribute path.Path, model string, now time.Time) {
+	deprecation, ok := modelDeprecations[model]
+	if !ok {
+		return
+	}
+
+	shutdown, err := time.Parse(time.DateOnly, deprecation.shutdown)
+	if err != nil || now.Before(shutdown.Add(-modelShutdownNotice)) {
+		return
+	}
+
+	summary := "Model shut down"
+	detail := fmt.Sprintf("The model %q was shut down by OpenAI on %s, requests using it fail.", model, deprecation.shutdown)
+	if now.Before(shutdown) {
+		summary = "Model shutting down soon"
+		detail = fmt.Sprintf("The model %q is deprecated and will be shut down by OpenAI on %s, requests using it will then fail.", model, deprecation.shutdown)
+	}
+	detail += fmt.Sprintf(" OpenAI recommends %q instead, see https://platform.openai.com/docs/deprecations.", deprecation.replacement)
+
+	diags.AddAttributeWarning(attribute, summary, detail)
+}
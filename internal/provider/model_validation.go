@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxModelSuggestions is the number of similar models suggested when the
+// configured model is not available.
+const maxModelSuggestions = 3
+
+// modifyPlanModel checks the model attribute of the plan against the models
+// available to the API key, so a misspelled or unavailable model fails the
+// plan with suggestions rather than the apply with a 404 Not Found. The model
+// is only checked against the API when it changes, and the check is skipped
+// when the models cannot be listed, e.g. with an API key restricted from the
+// Models API. The deprecated models are warned about, see modelDeprecations.
+func (c *openaiClient) modifyPlanModel(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var model, project types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("model"), &model)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project"), &project)...)
+	if resp.Diagnostics.HasError() || model.IsNull() || model.IsUnknown() {
+		return
+	}
+
+	addModelDeprecationWarning(&resp.Diagnostics, path.Root("model"), model.ValueString(), time.Now())
+
+	// The models cannot be listed before the provider is configured
+	if c == nil || project.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var previous types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("model"), &previous)...)
+		if resp.Diagnostics.HasError() || previous.Equal(model) {
+			return
+		}
+	}
+
+	models, err := c.listModels(withProject(ctx, project))
+	if err != nil {
+		tflog.Warn(ctx, "Could not list the OpenAI models, the model is not checked", map[string]any{"error": err.Error()})
+		return
+	}
+
+	for _, available := range models {
+		if available == model.ValueString() {
+			return
+		}
+	}
+
+	detail := fmt.Sprintf("The model %q is not available to the API key, check its spelling and the access of the project to it.", model.ValueString())
+	if suggestions := similarModels(model.ValueString(), models); len(suggestions) > 0 {
+		detail += " Did you mean " + strings.Join(suggestions, ", ") + "?"
+	}
+	resp.Diagnostics.AddAttributeError(path.Root("model"), "Model not available", detail)
+}
+
+// listModels returns the IDs of the models available to the API key. The
+// response is cached by the read cache, the models are listed once per
+// Terraform operation.
+func (c *openaiClient) listModels(ctx context.Context) ([]string, error) {
+	var page struct {
+		Data []modelObject `json:"data"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/models", nil, &page); err != nil {
+		return nil, err
+	}
+
+	models := make([]string, 0, len(page.Data))
+	for _, model := range page.Data {
+		models = append(models, model.ID)
+	}
+
+	return models, nil
+}
+
+// similarModels returns up to maxModelSuggestions models close to model, the
+// closest first: the models it is a prefix of, e.g. the snapshots of an
+// incomplete ID, and the models within a few typos of it.
+func similarModels(model string, models []string) []string {
+	type candidate struct {
+		id       string
+		distance int
+	}
+
+	maxDistance := max(2, len(model)/4)
+	var candidates []candidate
+	for _, id := range models {
+		distance := editDistance(strings.ToLower(model), strings.ToLower(id))
+		if strings.HasPrefix(id, model) {
+			distance = 0
+		}
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{id: id, distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].id < candidates[j].id
+	})
+
+	var suggestions []string
+	for _, candidate := range candidates[:min(len(candidates), maxModelSuggestions)] {
+		suggestions = append(suggestions, candidate.id)
+	}
+
+	return suggestions
+}
+
+// editDistance returns the Levenshtein distance between a and b, the number
+// of single character insertions, deletions and substitutions turning a into
+// b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			substitution := previous[j-1]
+			if a[i-1] != b[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSimilarModels(t *testing.T) {
+	models := []string{"gpt-4o", "gpt-4o-2024-08-06", "gpt-4o-mini", "o3", "o4-mini", "text-embedding-3-small"}
+
+	tests := map[string]struct {
+		model    string
+		expected []string
+	}{
+		"missing dash": {
+			model:    "gpt4o",
+			expected: []string{"gpt-4o"},
+		},
+		"typo": {
+			model:    "gpt-4o-min",
+			expected: []string{"gpt-4o-mini"},
+		},
+		"prefix": {
+			model:    "o4",
+			expected: []string{"o4-mini", "o3"},
+		},
+		"snapshots": {
+			model:    "gpt-4o-2024",
+			expected: []string{"gpt-4o-2024-08-06"},
+		},
+		"case": {
+			model:    "GPT-4o",
+			expected: []string{"gpt-4o"},
+		},
+		"unrelated": {
+			model: "dall-e-3",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if suggestions := similarModels(test.model, models); !reflect.DeepEqual(suggestions, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, suggestions)
+			}
+		})
+	}
+}
+
+func TestSimilarModelsLimit(t *testing.T) {
+	models := []string{"gpt-4o-2024-05-13", "gpt-4o-2024-08-06", "gpt-4o-2024-11-20", "gpt-4o-mini"}
+
+	if suggestions := similarModels("gpt-4o-", models); len(suggestions) != maxModelSuggestions {
+		t.Errorf("expected %d suggestions, got %v", maxModelSuggestions, suggestions)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"kitten", "sitting", 3},
+		{"gpt-4o", "gpt-4o", 0},
+		{"gpt4o", "gpt-4o", 1},
+		{"", "o3", 2},
+		{"o3", "", 2},
+		{"o3", "o1", 1},
+	}
+
+	for _, test := range tests {
+		if distance := editDistance(test.a, test.b); distance != test.expected {
+			t.Errorf("editDistance(%q, %q): expected %d, got %d", test.a, test.b, test.expected, distance)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &moderationDataSource{}
+	_ datasource.DataSourceWithConfigure = &moderationDataSource{}
+)
+
+// NewModerationDataSource is a helper function to simplify the provider implementation.
+func NewModerationDataSource() datasource.DataSource {
+	return &moderationDataSource{}
+}
+
+// moderationDataSource is the data source implementation.
+type moderationDataSource struct {
+	client *openaiClient
+}
+
+// moderationDataSourceModel maps the data source schema data.
+type moderationDataSourceModel struct {
+	Model   types.String            `tfsdk:"model"`
+	Input   types.List              `tfsdk:"input"`
+	Flagged types.Bool              `tfsdk:"flagged"`
+	Results []moderationResultModel `tfsdk:"results"`
+}
+
+// moderationResultModel maps the moderation result of a single input.
+type moderationResultModel struct {
+	Flagged        types.Bool `tfsdk:"flagged"`
+	Categories     types.Map  `tfsdk:"categories"`
+	CategoryScores types.Map  `tfsdk:"category_scores"`
+}
+
+// moderationRequest is the body of a moderation request.
+type moderationRequest struct {
+	Input []string `json:"input"`
+	Model string   `json:"model,omitempty"`
+}
+
+// moderationResponse is the body of a moderation response. Categories are
+// decoded as maps so new categories are exposed without a provider release.
+type moderationResponse struct {
+	Results []struct {
+		Flagged        bool               `json:"flagged"`
+		Categories     map[string]bool    `json:"categories"`
+		CategoryScores map[string]float64 `json:"category_scores"`
+	} `json:"results"`
+}
+
+// Metadata returns the data source type name.
+func (d *moderationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_moderation"
+}
+
+// Schema defines the schema for the data source.
+func (d *moderationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Classifies whether texts are potentially harmful.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Moderation model to use, such as `omni-moderation-latest` or `text-moderation-latest`. Defaults to the model selected by OpenAI.",
+				Optional:            true,
+			},
+			"input": schema.ListAttribute{
+				Description: "Texts to classify.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"flagged": schema.BoolAttribute{
+				Description: "Whether any of the inputs is flagged as potentially harmful.",
+				Computed:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "Moderation results, in the same order as `input`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"flagged": schema.BoolAttribute{
+							Description: "Whether the input is flagged as potentially harmful.",
+							Computed:    true,
+						},
+						"categories": schema.MapAttribute{
+							Description: "Whether the input is flagged, per category.",
+							ElementType: types.BoolType,
+							Computed:    true,
+						},
+						"category_scores": schema.MapAttribute{
+							Description: "Score of the input, between 0 and 1, per category.",
+							ElementType: types.Float64Type,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *moderationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *moderationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data moderationDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addModelDeprecationWarning(&resp.Diagnostics, path.Root("model"), data.Model.ValueString(), time.Now())
+
+	var input []string
+	diags = data.Input.ElementsAs(ctx, &input, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var moderation moderationResponse
+	err := d.client.doJSON(ctx, http.MethodPost, "/moderations", moderationRequest{
+		Input: input,
+		Model: data.Model.ValueString(),
+	}, &moderation)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Unable to moderate input", "", err)
+		return
+	}
+
+	flagged := false
+	data.Results = []moderationResultModel{}
+	for _, result := range moderation.Results {
+		categories, diags := types.MapValueFrom(ctx, types.BoolType, result.Categories)
+		resp.Diagnostics.Append(diags...)
+
+		scores, diags := types.MapValueFrom(ctx, types.Float64Type, result.CategoryScores)
+		resp.Diagnostics.Append(diags...)
+
+		data.Results = append(data.Results, moderationResultModel{
+			Flagged:        types.BoolValue(result.Flagged),
+			Categories:     categories,
+			CategoryScores: scores,
+		})
+		flagged = flagged || result.Flagged
+	}
+	data.Flagged = types.BoolValue(flagged)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// projectKey is the context key of the project overriding the project of the
+// provider.
+type projectKey struct{}
+
+// withProject returns a context whose requests are sent to the project, the
+// project of the provider is used when it is null or empty.
+func withProject(ctx context.Context, project types.String) context.Context {
+	if project.ValueString() == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, projectKey{}, project.ValueString())
+}
+
+// projectTransport sets the OpenAI-Project header of the requests, so they
+// are sent to the project of the provider or to the project of the context.
+type projectTransport struct {
+	next    http.RoundTripper
+	project string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *projectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	project, _ := req.Context().Value(projectKey{}).(string)
+	if project == "" {
+		project = t.project
+	}
+	// The requests of the Administration API are not scoped to a project.
+	if project == "" || isAdminPath(req.URL.Path) {
+		return t.next.RoundTrip(req)
+	}
+
+	// A RoundTripper must not modify the request.
+	req = req.Clone(req.Context())
+	req.Header.Set("OpenAI-Project", project)
+
+	return t.next.RoundTrip(req)
+}
@@ -2,23 +2,41 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	openai "github.com/sashabaranov/go-openai"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &openaiProvider{}
+	_ provider.Provider              = &openaiProvider{}
+	_ provider.ProviderWithFunctions = &openaiProvider{}
 )
 
+// headerNamePattern matches the valid names of HTTP headers.
+var headerNamePattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// defaultTimeout is the timeout of the operations of the resources, unless
+// set in their timeouts block.
+const defaultTimeout = 20 * time.Minute
+
 // New is a helper function to simplify provider server and testing implementation.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -35,7 +53,21 @@ type openaiProvider struct {
 
 // openaiProviderModel  maps provider schema data to a Go type
 type openaiProviderModel struct {
-	ApiKey types.String `tfsdk:"api_key"`
+	ApiKey                types.String  `tfsdk:"api_key"`
+	ApiKeyFile            types.String  `tfsdk:"api_key_file"`
+	AdminAPIKey           types.String  `tfsdk:"admin_api_key"`
+	BaseURL               types.String  `tfsdk:"base_url"`
+	OrganizationID        types.String  `tfsdk:"organization_id"`
+	ProjectID             types.String  `tfsdk:"project_id"`
+	Project               types.String  `tfsdk:"project"`
+	QuotaWarningThreshold types.Float64 `tfsdk:"quota_warning_threshold"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	RetryMaxDelay         types.String  `tfsdk:"retry_max_delay"`
+	RequestsPerMinute     types.Int64   `tfsdk:"requests_per_minute"`
+	RequestTimeout        types.String  `tfsdk:"request_timeout"`
+	DefaultHeaders        types.Map     `tfsdk:"default_headers"`
+	UploadPartSize        types.Int64   `tfsdk:"upload_part_size"`
+	UploadConcurrency     types.Int64   `tfsdk:"upload_concurrency"`
 }
 
 // Metadata returns the provider type name.
@@ -52,6 +84,91 @@ func (p *openaiProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 			"api_key": schema.StringAttribute{
 				Description: "The OpenAI API key for API operations. May also be provided via OPENAI_API_KEY environment variable.",
 				Optional:    true,
+				Sensitive:   true,
+			},
+			"api_key_file": schema.StringAttribute{
+				Description: "Path of a file holding the OpenAI API key, such as a secret mounted by Kubernetes or written by a Vault agent. Surrounding whitespace is ignored. May also be provided via OPENAI_API_KEY_FILE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key")),
+				},
+			},
+			"admin_api_key": schema.StringAttribute{
+				MarkdownDescription: "The OpenAI admin API key for the operations on the organization, required by the resources and data sources of the Administration API such as `openai_certificate` or `openai_users`. May also be provided via OPENAI_ADMIN_KEY environment variable. Defaults to `api_key` when it is an admin API key.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the OpenAI API, including its version, to send the requests to an OpenAI-compatible gateway, a proxy or a regional endpoint, e.g. `https://eu.api.openai.com/v1`. May also be provided via OPENAI_BASE_URL environment variable. Defaults to `https://api.openai.com/v1`.",
+				Optional:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "ID of the OpenAI organization the requests are sent to and billed to, for the accounts belonging to several organizations. May also be provided via OPENAI_ORG_ID environment variable. Defaults to the default organization of the API key.",
+				Optional:    true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the requests are sent to, in the `OpenAI-Project` header, unless overridden by the `project` of a resource. May also be provided via OPENAI_PROJECT_ID environment variable. Defaults to the default project of the API key.",
+				Optional:            true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Deprecated alias of `project_id`.",
+				DeprecationMessage:  "Use project_id instead.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("project_id")),
+				},
+			},
+			"quota_warning_threshold": schema.Float64Attribute{
+				Description: "Utilization of the rate limits, between 0 and 1, above which a warning is shown after a change, before the next runs fail with rate limit errors. Defaults to 0.8.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Number of retries, between 0 and 10, of the requests failing with a rate limit error, and of the GET and DELETE requests failing with a 500, 502 or 503 server error. The requests creating objects are not retried on server errors, they may have been processed. The retries wait for the delay requested by OpenAI, with an exponential backoff otherwise. Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"retry_max_delay": schema.StringAttribute{
+				MarkdownDescription: "Maximum delay before a retry, as a duration such as `30s` or `2m`. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "Maximum number of requests sent to OpenAI per minute, including the retries, shared by all the resources and data sources of the plan or apply. Set it below the rate limits of the organization, so the changes made to many objects at once do not fail with rate limit errors. Defaults to no limit.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of each request sent to OpenAI, including the upload of its body and the download of the response, as a duration such as `30s` or `5m`. The delays before the retries are not included. Defaults to no timeout, other than the `timeouts` of the resources.",
+				Optional:            true,
+			},
+			"default_headers": schema.MapAttribute{
+				MarkdownDescription: "HTTP headers added to every request sent to OpenAI, such as the tenant or authentication headers required by a corporate gateway. The headers set by the provider, such as `Authorization` or `OpenAI-Project`, take precedence.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(headerNamePattern, "must be a valid HTTP header name")),
+				},
+			},
+			"upload_part_size": schema.Int64Attribute{
+				Description: "Size, in MB between 1 and 64, of the parts of the files larger than 512 MB, which are uploaded in parts with the Uploads API. Defaults to 64.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 64),
+				},
+			},
+			"upload_concurrency": schema.Int64Attribute{
+				Description: "Number of parts, between 1 and 16, of a file larger than 512 MB uploaded at once. Defaults to 4.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16),
+				},
 			},
 		},
 	}
@@ -69,31 +186,152 @@ func (p *openaiProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	apiKey := os.Getenv("OPENAI_API_KEY")
+	apiKeyFile := os.Getenv("OPENAI_API_KEY_FILE")
+
+	// The key file of the configuration takes precedence over the key of the
+	// environment.
+	if !config.ApiKeyFile.IsNull() {
+		apiKey = ""
+		apiKeyFile = config.ApiKeyFile.ValueString()
+	}
 
 	if !config.ApiKey.IsNull() {
 		apiKey = config.ApiKey.ValueString()
 	}
 
+	if apiKey == "" && apiKeyFile != "" {
+		content, err := os.ReadFile(apiKeyFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"Unable to read OpenAI API key file",
+				fmt.Sprintf("The provider cannot read the OpenAI API key from %s: %s", apiKeyFile, err.Error()),
+			)
+			return
+		}
+		apiKey = strings.TrimSpace(string(content))
+	}
+
 	if apiKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
 			"Missing OpenAI API key",
 			"The provider cannot create the OpenAI API client as there is a missing or empty value for the OpenAI API key. "+
-				"Set the api_key value in the configuration or use the OPENAI_API_KEY environment variable. "+
+				"Set the api_key or api_key_file value in the configuration or use the OPENAI_API_KEY or OPENAI_API_KEY_FILE environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	baseURL := os.Getenv("OPENAI_BASE_URL")
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
+	}
+
+	if baseURL != "" {
+		if u, err := url.Parse(baseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("base_url"),
+				"Invalid OpenAI base URL",
+				fmt.Sprintf("The base URL %q of the OpenAI API must be an absolute http or https URL, such as https://api.openai.com/v1. "+
+					"Set the base_url value in the configuration or use the OPENAI_BASE_URL environment variable.", baseURL),
+			)
+		}
+	}
+
+	retryMaxDelay := defaultRetryMaxDelay
+	if !config.RetryMaxDelay.IsNull() {
+		delay, err := time.ParseDuration(config.RetryMaxDelay.ValueString())
+		if err != nil || delay <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_delay"),
+				"Invalid retry maximum delay",
+				fmt.Sprintf("The maximum delay before a retry %q must be a positive duration, such as 30s or 2m.", config.RetryMaxDelay.ValueString()),
+			)
+		}
+		retryMaxDelay = delay
+	}
+
+	var requestTimeout time.Duration
+	if !config.RequestTimeout.IsNull() {
+		timeout, err := time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid request timeout",
+				fmt.Sprintf("The timeout of the requests %q must be a positive duration, such as 30s or 5m.", config.RequestTimeout.ValueString()),
+			)
+		}
+		requestTimeout = timeout
+	}
+
+	var defaultHeaders map[string]string
+	resp.Diagnostics.Append(config.DefaultHeaders.ElementsAs(ctx, &defaultHeaders, false)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_key")
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_key", "admin_api_key", "default_headers")
 
 	tflog.Debug(ctx, "Creating OpenAI client")
 
 	// Create a new OpenAI client using the configuration values
-	client := openai.NewClient(apiKey)
+	project := os.Getenv("OPENAI_PROJECT_ID")
+	if !config.Project.IsNull() {
+		project = config.Project.ValueString()
+	}
+	if !config.ProjectID.IsNull() {
+		project = config.ProjectID.ValueString()
+	}
+
+	organization := os.Getenv("OPENAI_ORG_ID")
+	if !config.OrganizationID.IsNull() {
+		organization = config.OrganizationID.ValueString()
+	}
+
+	adminAPIKey := os.Getenv("OPENAI_ADMIN_KEY")
+	if !config.AdminAPIKey.IsNull() {
+		adminAPIKey = config.AdminAPIKey.ValueString()
+	}
+	if adminAPIKey == "" && strings.HasPrefix(apiKey, adminAPIKeyPrefix) {
+		adminAPIKey = apiKey
+	}
+
+	quotaWarningThreshold := defaultQuotaWarningThreshold
+	if !config.QuotaWarningThreshold.IsNull() {
+		quotaWarningThreshold = config.QuotaWarningThreshold.ValueFloat64()
+	}
+
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	uploadPartSize := defaultUploadPartSize
+	if !config.UploadPartSize.IsNull() {
+		uploadPartSize = int(config.UploadPartSize.ValueInt64()) << 20
+	}
+
+	uploadConcurrency := defaultUploadConcurrency
+	if !config.UploadConcurrency.IsNull() {
+		uploadConcurrency = int(config.UploadConcurrency.ValueInt64())
+	}
+
+	client := newOpenAIClient(openaiClientOptions{
+		APIKey:                apiKey,
+		AdminAPIKey:           adminAPIKey,
+		BaseURL:               baseURL,
+		Organization:          organization,
+		Project:               project,
+		QuotaWarningThreshold: quotaWarningThreshold,
+		MaxRetries:            maxRetries,
+		RetryMaxDelay:         retryMaxDelay,
+		RequestsPerMinute:     int(config.RequestsPerMinute.ValueInt64()),
+		RequestTimeout:        requestTimeout,
+		DefaultHeaders:        defaultHeaders,
+		UploadPartSize:        uploadPartSize,
+		UploadConcurrency:     uploadConcurrency,
+	})
 
 	// Make the OpenAI client available during DataSource and Resource
 	// type Configure methods.
@@ -106,7 +344,21 @@ func (p *openaiProvider) Configure(ctx context.Context, req provider.ConfigureRe
 // DataSources defines the data sources implemented in the provider.
 func (p *openaiProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAPIHealthDataSource,
 		NewAssistantDataSource,
+		NewAudioTranscriptionDataSource,
+		NewAudioTranslationDataSource,
+		NewBatchOutputDataSource,
+		NewEmbeddingDataSource,
+		NewEvalsDataSource,
+		NewFileDataSource,
+		NewFilesDataSource,
+		NewModelDataSource,
+		NewModerationDataSource,
+		NewRateLimitsDataSource,
+		NewThreadDataSource,
+		NewUsersDataSource,
+		NewVectorStoresDataSource,
 	}
 }
 
@@ -115,5 +367,45 @@ func (p *openaiProvider) Resources(_ context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		NewAssistantResource,
 		NewAssistantFileResource,
+		NewChatCompletionResource,
+		NewContainerResource,
+		NewContainerFileResource,
+		NewConversationResource,
+		NewEmbeddingFileResource,
+		NewEvalResource,
+		NewEvalRunResource,
+		NewFileResource,
+		NewFileDirectoryResource,
+		NewVectorStoreResource,
+		NewBatchResource,
+		NewCertificateResource,
+		NewImageGenerationResource,
+		NewImageEditResource,
+		NewImageVariationResource,
+		NewResponseResource,
+		NewSpeechResource,
+		NewThreadResource,
+		NewThreadMessageResource,
+		NewThreadRunResource,
+		NewVideoGenerationResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *openaiProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewChatMessagesFunction,
+		NewChunkTextFunction,
+		NewCosineSimilarityFunction,
+		NewCountTokensFunction,
+		NewEstimateChatCostFunction,
+		NewJSONLDecodeFunction,
+		NewJSONLEncodeFunction,
+		NewRenderPromptFunction,
+		NewResolveModelAliasFunction,
+		NewSanitizeMetadataFunction,
+		NewTruncateToTokensFunction,
+		NewValidateFinetuneJSONLFunction,
+		NewValidateJSONSchemaFunction,
 	}
 }
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories are the providers of the acceptance tests,
+// served in the process of the tests.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"openai": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccPreCheck points the provider of the acceptance tests to a mock of the
+// OpenAI API, unless OPENAI_ACC_LIVE is set to run them against the OpenAI
+// account of OPENAI_API_KEY. It returns the mock, or nil when the tests run
+// against OpenAI; the objects they leave behind there are deleted by the
+// sweepers.
+func testAccPreCheck(t *testing.T) *mockOpenAI {
+	t.Helper()
+
+	if os.Getenv("OPENAI_ACC_LIVE") != "" {
+		if os.Getenv("OPENAI_API_KEY") == "" {
+			t.Fatal("OPENAI_API_KEY must be set to run the acceptance tests against OpenAI")
+		}
+		return nil
+	}
+
+	server := newMockOpenAI(t)
+	t.Setenv("OPENAI_API_KEY", "sk-test")
+	t.Setenv("OPENAI_BASE_URL", server.URL)
+
+	return server
+}
+
+// testAccClient returns a client of the OpenAI account set in the environment
+// of the acceptance tests and of the sweepers.
+func testAccClient() (*openaiClient, error) {
+	apiKey := os.Getenv("OPENAI_API_KEY")
+	if apiKey == "" {
+		return nil, fmt.Errorf("OPENAI_API_KEY must be set to run the acceptance tests and the sweepers")
+	}
+
+	return newOpenAIClient(openaiClientOptions{
+		APIKey:            apiKey,
+		BaseURL:           os.Getenv("OPENAI_BASE_URL"),
+		Organization:      os.Getenv("OPENAI_ORG_ID"),
+		Project:           os.Getenv("OPENAI_PROJECT_ID"),
+		MaxRetries:        defaultMaxRetries,
+		RetryMaxDelay:     defaultRetryMaxDelay,
+		UploadPartSize:    defaultUploadPartSize,
+		UploadConcurrency: defaultUploadConcurrency,
+	}), nil
+}
+
+// testAccCheckDestroyed checks the objects of the resources of the type are
+// deleted from OpenAI, the path of an object being the prefix followed by its
+// ID.
+func testAccCheckDestroyed(resourceType, pathPrefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := testAccClient()
+		if err != nil {
+			return err
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			err := client.doJSON(context.Background(), http.MethodGet, pathPrefix+rs.Primary.ID, nil, nil)
+			if err == nil {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+			if errorStatusCode(err) != http.StatusNotFound {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestProvider(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The schemas of the provider, resources and data sources are validated
+	// when they are returned.
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Errorf("unexpected error: %s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// defaultQuotaWarningThreshold is the utilization of the rate limits above
+// which a warning is shown, unless set in the provider configuration.
+const defaultQuotaWarningThreshold = 0.8
+
+// quotaTransport records the utilization of the rate limits reported in the
+// x-ratelimit-* headers of the responses, so the changes made close to the
+// limits can warn about it.
+type quotaTransport struct {
+	next      http.RoundTripper
+	threshold float64
+
+	mu          sync.Mutex
+	utilization map[string]float64
+	warned      bool
+}
+
+// newQuotaTransport wraps next with the recording of the rate limits.
+func newQuotaTransport(next http.RoundTripper, threshold float64) *quotaTransport {
+	return &quotaTransport{
+		next:        next,
+		threshold:   threshold,
+		utilization: map[string]float64{},
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *quotaTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, limit := range []string{"requests", "tokens"} {
+		total, err := strconv.ParseFloat(resp.Header.Get("x-ratelimit-limit-"+limit), 64)
+		if err != nil || total <= 0 {
+			continue
+		}
+		remaining, err := strconv.ParseFloat(resp.Header.Get("x-ratelimit-remaining-"+limit), 64)
+		if err != nil {
+			continue
+		}
+
+		t.utilization[limit] = 1 - remaining/total
+	}
+
+	return resp, nil
+}
+
+// warn adds a warning when the latest responses report a utilization of the
+// rate limits above the threshold. The warning is only added once, the
+// following changes would otherwise all repeat it.
+func (t *quotaTransport) warn(diags *diag.Diagnostics) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.warned {
+		return
+	}
+
+	var usages []string
+	for limit, utilization := range t.utilization {
+		if utilization >= t.threshold {
+			usages = append(usages, fmt.Sprintf("%.0f%% of the %s limit", utilization*100, limit))
+		}
+	}
+	if len(usages) == 0 {
+		return
+	}
+	sort.Strings(usages)
+
+	t.warned = true
+	diags.AddWarning(
+		"OpenAI rate limits nearly reached",
+		fmt.Sprintf("The latest requests to OpenAI used %s of the model, above the quota_warning_threshold of %.0f%%. "+
+			"The next runs may fail with rate limit errors, see https://platform.openai.com/docs/guides/rate-limits",
+			strings.Join(usages, " and "), t.threshold*100),
+	)
+}
+
+// warnNearQuota adds a warning when the rate limits are nearly reached.
+func (c *openaiClient) warnNearQuota(diags *diag.Diagnostics) {
+	c.quota.warn(diags)
+}
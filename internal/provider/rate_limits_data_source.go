@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rateLimitsDataSource{}
+	_ datasource.DataSourceWithConfigure = &rateLimitsDataSource{}
+)
+
+// NewRateLimitsDataSource is a helper function to simplify the provider implementation.
+func NewRateLimitsDataSource() datasource.DataSource {
+	return &rateLimitsDataSource{}
+}
+
+// rateLimitsDataSource is the data source implementation.
+type rateLimitsDataSource struct {
+	client *openaiClient
+}
+
+// rateLimitsDataSourceModel maps the data source schema data.
+type rateLimitsDataSourceModel struct {
+	Model             types.String `tfsdk:"model"`
+	RateLimited       types.Bool   `tfsdk:"rate_limited"`
+	LimitRequests     types.Int64  `tfsdk:"limit_requests"`
+	LimitTokens       types.Int64  `tfsdk:"limit_tokens"`
+	RemainingRequests types.Int64  `tfsdk:"remaining_requests"`
+	RemainingTokens   types.Int64  `tfsdk:"remaining_tokens"`
+	ResetRequests     types.String `tfsdk:"reset_requests"`
+	ResetTokens       types.String `tfsdk:"reset_tokens"`
+}
+
+// rateLimitsChatRequest is the smallest chat completion request, sent to read
+// the rate limit headers of chat models.
+type rateLimitsChatRequest struct {
+	Model               string              `json:"model"`
+	Messages            []map[string]string `json:"messages"`
+	MaxCompletionTokens int64               `json:"max_completion_tokens"`
+}
+
+// rateLimitsEmbeddingRequest is the smallest embeddings request, sent to read
+// the rate limit headers of embedding models.
+type rateLimitsEmbeddingRequest struct {
+	Model string `json:"model"`
+	Input string `json:"input"`
+}
+
+// Metadata returns the data source type name.
+func (d *rateLimitsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rate_limits"
+}
+
+// Schema defines the schema for the data source.
+func (d *rateLimitsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the remaining rate limits of a model, read from the `x-ratelimit-*` headers of a minimal request: a one token chat completion, or the embedding of a single word for `text-embedding` models. The request counts against the limits and is billed.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Model to report the rate limits of, such as `gpt-4o-mini` or `text-embedding-3-small`.",
+				Required:            true,
+			},
+			"rate_limited": schema.BoolAttribute{
+				Description: "Whether the request was rejected because a rate limit is exhausted.",
+				Computed:    true,
+			},
+			"limit_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests allowed before the limit is exhausted.",
+				Computed:    true,
+			},
+			"limit_tokens": schema.Int64Attribute{
+				Description: "Maximum number of tokens allowed before the limit is exhausted.",
+				Computed:    true,
+			},
+			"remaining_requests": schema.Int64Attribute{
+				Description: "Number of requests remaining before the limit is exhausted.",
+				Computed:    true,
+			},
+			"remaining_tokens": schema.Int64Attribute{
+				Description: "Number of tokens remaining before the limit is exhausted.",
+				Computed:    true,
+			},
+			"reset_requests": schema.StringAttribute{
+				MarkdownDescription: "Time until the request limit is reset to its initial value, such as `1s` or `6m0s`.",
+				Computed:            true,
+			},
+			"reset_tokens": schema.StringAttribute{
+				MarkdownDescription: "Time until the token limit is reset to its initial value, such as `1s` or `6m0s`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rateLimitsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rateLimitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data rateLimitsDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := data.Model.ValueString()
+
+	var header http.Header
+	var err error
+	if strings.HasPrefix(model, "text-embedding") {
+		header, err = d.client.doJSONHeader(ctx, http.MethodPost, "/embeddings", rateLimitsEmbeddingRequest{
+			Model: model,
+			Input: "ping",
+		}, nil)
+	} else {
+		header, err = d.client.doJSONHeader(ctx, http.MethodPost, "/chat/completions", rateLimitsChatRequest{
+			Model:               model,
+			Messages:            []map[string]string{{"role": "user", "content": "ping"}},
+			MaxCompletionTokens: 1,
+		}, nil)
+	}
+
+	// An exhausted limit is a result, not an error: the headers still
+	// report when the limit is reset.
+	rateLimited := errorStatusCode(err) == http.StatusTooManyRequests
+	if err != nil && !rateLimited {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Unable to read rate limits", "", err)
+		return
+	}
+
+	data.RateLimited = types.BoolValue(rateLimited)
+	data.LimitRequests = rateLimitHeaderInt64(header, "X-Ratelimit-Limit-Requests")
+	data.LimitTokens = rateLimitHeaderInt64(header, "X-Ratelimit-Limit-Tokens")
+	data.RemainingRequests = rateLimitHeaderInt64(header, "X-Ratelimit-Remaining-Requests")
+	data.RemainingTokens = rateLimitHeaderInt64(header, "X-Ratelimit-Remaining-Tokens")
+	data.ResetRequests = rateLimitHeaderString(header, "X-Ratelimit-Reset-Requests")
+	data.ResetTokens = rateLimitHeaderString(header, "X-Ratelimit-Reset-Tokens")
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// rateLimitHeaderInt64 returns the integer value of a rate limit header, or
+// null when the header is not reported.
+func rateLimitHeaderInt64(header http.Header, key string) types.Int64 {
+	value, err := strconv.ParseInt(header.Get(key), 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(value)
+}
+
+// rateLimitHeaderString returns the value of a rate limit header, or null
+// when the header is not reported.
+func rateLimitHeaderString(header http.Header, key string) types.String {
+	value := header.Get(key)
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
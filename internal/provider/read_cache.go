@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// readCacheTransport is the transport of the client. It caches the JSON
+// responses of GET requests for the lifetime of the provider, which is a
+// single Terraform operation, and sends identical GET requests in flight only
+// once. Large configurations read the same objects many times per plan, e.g.
+// an assistant managed by a resource and looked up by data sources.
+//
+// Any other request clears the cache, so a read never returns an object older
+// than the last change made by the provider.
+type readCacheTransport struct {
+	next  http.RoundTripper
+	group singleflight.Group
+
+	mu         sync.Mutex
+	generation int
+	responses  map[string]*cachedResponse
+}
+
+// cachedResponse is a response kept by the read cache.
+type cachedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// freshReadsKey is the context key disabling the read cache.
+type freshReadsKey struct{}
+
+// withFreshReads returns a context whose requests bypass the read cache, for
+// the polls waiting for an object to change.
+func withFreshReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, freshReadsKey{}, true)
+}
+
+// newReadCacheTransport wraps next with a read cache.
+func newReadCacheTransport(next http.RoundTripper) *readCacheTransport {
+	return &readCacheTransport{
+		next:      next,
+		responses: map[string]*cachedResponse{},
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *readCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		// Clear the cache both before and after the change, a read in flight
+		// may otherwise cache the object as it was before the change.
+		t.clear()
+		defer t.clear()
+		return t.next.RoundTrip(req)
+	}
+
+	if fresh, _ := req.Context().Value(freshReadsKey{}).(bool); fresh {
+		return t.next.RoundTrip(req)
+	}
+
+	// The headers are part of the key, they hold the API key, the
+	// organization and the version of the Assistants API.
+	key := req.URL.String() + "\n" + fmt.Sprint(req.Header)
+
+	if cached := t.get(key); cached != nil {
+		return cached.response(req), nil
+	}
+
+	value, err, _ := t.group.Do(key, func() (any, error) {
+		t.mu.Lock()
+		generation := t.generation
+		t.mu.Unlock()
+
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		cached := &cachedResponse{statusCode: resp.StatusCode, header: resp.Header, body: body}
+		mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if resp.StatusCode == http.StatusOK && mediaType == "application/json" {
+			t.put(key, cached, generation)
+		}
+
+		return cached, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value.(*cachedResponse).response(req), nil
+}
+
+// get returns the cached response of the key, nil when there is none.
+func (t *readCacheTransport) get(key string) *cachedResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.responses[key]
+}
+
+// put caches the response of the key, unless the cache has been cleared since
+// the request was sent.
+func (t *readCacheTransport) put(key string, cached *cachedResponse, generation int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if generation == t.generation {
+		t.responses[key] = cached
+	}
+}
+
+// clear empties the cache.
+func (t *readCacheTransport) clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.generation++
+	t.responses = map[string]*cachedResponse{}
+}
+
+// response returns a new response to req with the cached status, headers and
+// body.
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.statusCode, http.StatusText(c.statusCode)),
+		StatusCode:    c.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
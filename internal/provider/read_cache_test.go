@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestReadCacheTransport(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/missing":
+			writeMockError(w, http.StatusNotFound, "Not found.")
+		case "/text":
+			io.WriteString(w, "text")
+		default:
+			writeMockJSON(w, map[string]any{"id": "asst_abc123"})
+		}
+	}))
+	defer server.Close()
+
+	transport := newReadCacheTransport(http.DefaultTransport)
+	client := &http.Client{Transport: transport}
+
+	get := func(ctx context.Context, path string, header string) {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", header)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		if _, err := io.ReadAll(resp.Body); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expectRequests := func(expected int32) {
+		t.Helper()
+
+		if requests.Load() != expected {
+			t.Errorf("expected %d requests, got %d", expected, requests.Load())
+		}
+	}
+
+	ctx := context.Background()
+
+	get(ctx, "/assistants/asst_abc123", "Bearer sk-test")
+	get(ctx, "/assistants/asst_abc123", "Bearer sk-test")
+	expectRequests(1)
+
+	// The headers are part of the key.
+	get(ctx, "/assistants/asst_abc123", "Bearer sk-other")
+	expectRequests(2)
+
+	// A change clears the cache.
+	resp, err := client.Post(server.URL+"/assistants/asst_abc123", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	expectRequests(3)
+
+	get(ctx, "/assistants/asst_abc123", "Bearer sk-test")
+	expectRequests(4)
+	get(ctx, "/assistants/asst_abc123", "Bearer sk-test")
+	expectRequests(4)
+
+	// The fresh reads bypass the cache.
+	get(withFreshReads(ctx), "/assistants/asst_abc123", "Bearer sk-test")
+	expectRequests(5)
+
+	// Only the successful JSON responses are cached.
+	get(ctx, "/missing", "Bearer sk-test")
+	get(ctx, "/missing", "Bearer sk-test")
+	expectRequests(7)
+	get(ctx, "/text", "Bearer sk-test")
+	get(ctx, "/text", "Bearer sk-test")
+	expectRequests(9)
+}
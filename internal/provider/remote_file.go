@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+)
+
+// sourceURLPattern matches the URLs the files can be downloaded from.
+var sourceURLPattern = regexp.MustCompile(`^https?://`)
+
+// sha256Pattern matches a hex encoded SHA-256 checksum.
+var sha256Pattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// downloadFile downloads the file at rawURL to a temporary file, streamed
+// rather than read in memory, and returns it with its SHA-256 checksum,
+// computed while downloading. When expectedSHA256 is not empty, the checksum
+// must match it. The request is sent with a client of its own, the API key of
+// OpenAI must not be sent to the host of the file. The temporary file must be
+// removed once read with removeDownloadedFile.
+func downloadFile(ctx context.Context, rawURL, expectedSHA256 string) (*os.File, *io.SectionReader, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, "", fmt.Errorf("unexpected status %s downloading %s", resp.Status, rawURL)
+	}
+
+	file, err := os.CreateTemp("", "terraform-provider-openai-*")
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), resp.Body)
+	if err != nil {
+		removeDownloadedFile(file)
+		return nil, nil, "", err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if expectedSHA256 != "" && checksum != expectedSHA256 {
+		removeDownloadedFile(file)
+		return nil, nil, "", fmt.Errorf("the SHA-256 checksum of %s is %s, expected %s", rawURL, checksum, expectedSHA256)
+	}
+
+	return file, io.NewSectionReader(file, 0, size), checksum, nil
+}
+
+// removeDownloadedFile closes and removes a temporary file returned by
+// downloadFile.
+func removeDownloadedFile(file *os.File) {
+	file.Close()
+	os.Remove(file.Name())
+}
+
+// sourceURLFilename returns the name of the file at rawURL, the last element
+// of its path.
+func sourceURLFilename(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Path == "" {
+		return ""
+	}
+
+	name := path.Base(u.Path)
+	if name == "/" || name == "." {
+		return ""
+	}
+
+	return name
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestDownloadFile(t *testing.T) {
+	content := `{"prompt":"Hello","completion":"World"}` + "\n"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/train.jsonl" {
+			http.NotFound(w, r)
+			return
+		}
+		io.WriteString(w, content)
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	file, section, checksum, err := downloadFile(ctx, server.URL+"/train.jsonl", sha256Hex([]byte(content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	downloaded, err := io.ReadAll(section)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(downloaded) != content {
+		t.Errorf("expected content %q, got %q", content, downloaded)
+	}
+	if checksum != sha256Hex([]byte(content)) {
+		t.Errorf("unexpected checksum %s", checksum)
+	}
+
+	removeDownloadedFile(file)
+	if _, err := os.Stat(file.Name()); !os.IsNotExist(err) {
+		t.Errorf("expected the temporary file to be removed, got %v", err)
+	}
+
+	// A checksum mismatch is an error, and no temporary file is left.
+	if _, _, _, err := downloadFile(ctx, server.URL+"/train.jsonl", strings.Repeat("0", 64)); err == nil || !strings.Contains(err.Error(), "expected "+strings.Repeat("0", 64)) {
+		t.Errorf("expected a checksum error, got %v", err)
+	}
+
+	if _, _, _, err := downloadFile(ctx, server.URL+"/missing.jsonl", ""); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
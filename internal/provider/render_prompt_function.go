@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &renderPromptFunction{}
+)
+
+// promptPlaceholderPattern matches the placeholders of a prompt template, such
+// as {{name}} or {{ customer.name }}.
+var promptPlaceholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.-]*)\s*\}\}`)
+
+// NewRenderPromptFunction is a helper function to simplify the provider implementation.
+func NewRenderPromptFunction() function.Function {
+	return &renderPromptFunction{}
+}
+
+// renderPromptFunction is the function implementation.
+type renderPromptFunction struct{}
+
+// Metadata returns the function name.
+func (f *renderPromptFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_prompt"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *renderPromptFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Renders a prompt template",
+		MarkdownDescription: "Replaces the `{{name}}` placeholders of a prompt template with the values of the variables. The values are inserted as is, the placeholders they contain are not replaced, and the placeholders without a variable are reported as an error rather than left in the prompt. The placeholders do not conflict with the `${}` interpolation of Terraform, so the template can be read with `file`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "template",
+				MarkdownDescription: "Prompt template, with placeholders such as `{{customer_name}}`.",
+			},
+			function.MapParameter{
+				Name:        "vars",
+				ElementType: types.StringType,
+				Description: "Values of the placeholders, by name.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the template.
+func (f *renderPromptFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var template string
+	var vars map[string]string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &template, &vars)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var missing []string
+	prompt := promptPlaceholderPattern.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := promptPlaceholderPattern.FindStringSubmatch(placeholder)[1]
+		value, ok := vars[name]
+		if !ok {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+			return placeholder
+		}
+		return value
+	})
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddArgumentError(1, "Missing prompt variables", "The template has placeholders without a value in vars: "+strings.Join(missing, ", ")+".")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, prompt)...)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRenderPromptFunction(t *testing.T) {
+	vars := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"company":       types.StringValue("Acme"),
+		"customer.name": types.StringValue("{{company}} customer"),
+	})
+
+	tests := map[string]struct {
+		template string
+		expected string
+		missing  []string
+	}{
+		"placeholders": {
+			template: "You are the assistant of {{company}}. Greet {{ customer.name }}.",
+			expected: "You are the assistant of Acme. Greet {{company}} customer.",
+		},
+		"no placeholders": {
+			template: "You are an assistant. Interpolations such as ${var} are kept.",
+			expected: "You are an assistant. Interpolations such as ${var} are kept.",
+		},
+		"missing variables": {
+			template: "{{greeting}} from {{company}}, {{signature}}. {{greeting}}",
+			missing:  []string{"greeting, signature."},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := runFunction(t, NewRenderPromptFunction(), types.StringValue(test.template), vars)
+
+			if len(test.missing) > 0 {
+				if !diags.HasError() {
+					t.Fatal("expected an error")
+				}
+				for _, missing := range test.missing {
+					if !strings.Contains(diags[0].Detail(), missing) {
+						t.Errorf("expected the error to name %q, got %q", missing, diags[0].Detail())
+					}
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !result.Equal(types.StringValue(test.expected)) {
+				t.Errorf("expected %q, got %s", test.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// timeoutTransport bounds the duration of each attempt of a request, from
+// sending it to reading the body of its response. Unlike the Timeout of an
+// http.Client, the delays between the retries are not included.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+// newTimeoutTransport wraps next with the timeout of the requests. The
+// requests have no timeout of their own when timeout is 0.
+func newTimeoutTransport(next http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	if timeout <= 0 {
+		return next
+	}
+
+	return &timeoutTransport{
+		next:    next,
+		timeout: timeout,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The timeout also applies to the read of the body, the context is
+	// released once it is closed.
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnCloseBody is a response body cancelling the context of its request
+// when closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer.
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &resolveModelAliasFunction{}
+)
+
+// modelAliases maps the model aliases to the snapshot they point to, see
+// https://platform.openai.com/docs/models. The table ships with the provider
+// so the snapshot does not change until the provider is upgraded.
+var modelAliases = map[string]string{
+	"gpt-5":               "gpt-5-2025-08-07",
+	"gpt-5-mini":          "gpt-5-mini-2025-08-07",
+	"gpt-5-nano":          "gpt-5-nano-2025-08-07",
+	"gpt-4.1":             "gpt-4.1-2025-04-14",
+	"gpt-4.1-mini":        "gpt-4.1-mini-2025-04-14",
+	"gpt-4.1-nano":        "gpt-4.1-nano-2025-04-14",
+	"gpt-4o":              "gpt-4o-2024-08-06",
+	"gpt-4o-mini":         "gpt-4o-mini-2024-07-18",
+	"o1":                  "o1-2024-12-17",
+	"o3":                  "o3-2025-04-16",
+	"o3-mini":             "o3-mini-2025-01-31",
+	"o4-mini":             "o4-mini-2025-04-16",
+	"gpt-4-turbo":         "gpt-4-turbo-2024-04-09",
+	"gpt-4-turbo-preview": "gpt-4-0125-preview",
+	"gpt-4":               "gpt-4-0613",
+	"gpt-3.5-turbo":       "gpt-3.5-turbo-0125",
+}
+
+// NewResolveModelAliasFunction is a helper function to simplify the provider implementation.
+func NewResolveModelAliasFunction() function.Function {
+	return &resolveModelAliasFunction{}
+}
+
+// resolveModelAliasFunction is the function implementation.
+type resolveModelAliasFunction struct{}
+
+// Metadata returns the function name.
+func (f *resolveModelAliasFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "resolve_model_alias"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *resolveModelAliasFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Resolves a model alias to its snapshot",
+		MarkdownDescription: "Returns the dated snapshot a model alias points to, such as `gpt-4o-2024-08-06` for `gpt-4o`, to pin the snapshot in the configuration. The aliases are resolved with a table shipped with the provider, not with the API, so the result only changes when the provider is upgraded. The snapshots and the models without snapshots are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "model",
+				MarkdownDescription: "Model alias, such as `gpt-4o` or `gpt-4-turbo-preview`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run resolves the alias.
+func (f *resolveModelAliasFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var model string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if snapshot, ok := modelAliases[model]; ok {
+		model = snapshot
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+}
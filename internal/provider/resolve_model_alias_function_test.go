@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveModelAliasFunction(t *testing.T) {
+	tests := map[string]string{
+		"gpt-4o":              "gpt-4o-2024-08-06",
+		"gpt-4-turbo-preview": "gpt-4-0125-preview",
+		"gpt-4o-2024-05-13":   "gpt-4o-2024-05-13",
+		"whisper-1":           "whisper-1",
+	}
+
+	for model, expected := range tests {
+		t.Run(model, func(t *testing.T) {
+			result, diags := runFunction(t, NewResolveModelAliasFunction(), types.StringValue(model))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !result.Equal(types.StringValue(expected)) {
+				t.Errorf("expected %q, got %s", expected, result)
+			}
+		})
+	}
+
+	// An alias resolves to a snapshot in a single step.
+	for alias, snapshot := range modelAliases {
+		if _, ok := modelAliases[snapshot]; ok || snapshot == alias {
+			t.Errorf("expected %q to resolve to a snapshot, got %q", alias, snapshot)
+		}
+	}
+}
@@ -0,0 +1,596 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &responseResource{}
+	_ resource.ResourceWithConfigure  = &responseResource{}
+	_ resource.ResourceWithModifyPlan = &responseResource{}
+)
+
+// NewResponseResource is a helper function to simplify the provider implementation.
+func NewResponseResource() resource.Resource {
+	return &responseResource{}
+}
+
+// responseResource is the resource implementation.
+type responseResource struct {
+	client *openaiClient
+}
+
+// responseResourceModel maps the resource schema data.
+type responseResourceModel struct {
+	ID                 types.String                  `tfsdk:"id"`
+	Model              types.String                  `tfsdk:"model"`
+	Input              types.String                  `tfsdk:"input"`
+	Instructions       types.String                  `tfsdk:"instructions"`
+	PreviousResponseID types.String                  `tfsdk:"previous_response_id"`
+	Temperature        types.Float64                 `tfsdk:"temperature"`
+	TopP               types.Float64                 `tfsdk:"top_p"`
+	MaxOutputTokens    types.Int64                   `tfsdk:"max_output_tokens"`
+	Metadata           types.Map                     `tfsdk:"metadata"`
+	WebSearch          *responseWebSearchModel       `tfsdk:"web_search"`
+	FileSearch         *responseFileSearchModel      `tfsdk:"file_search"`
+	CodeInterpreter    *responseCodeInterpreterModel `tfsdk:"code_interpreter"`
+	Status             types.String                  `tfsdk:"status"`
+	OutputText         types.String                  `tfsdk:"output_text"`
+	Output             types.String                  `tfsdk:"output"`
+	CreatedAt          types.Int64                   `tfsdk:"created_at"`
+	Project            types.String                  `tfsdk:"project"`
+	Timeouts           timeouts.Value                `tfsdk:"timeouts"`
+}
+
+// responseWebSearchModel maps the web_search tool configuration.
+type responseWebSearchModel struct {
+	SearchContextSize types.String `tfsdk:"search_context_size"`
+}
+
+// responseFileSearchModel maps the file_search tool configuration.
+type responseFileSearchModel struct {
+	VectorStoreIDs types.List  `tfsdk:"vector_store_ids"`
+	MaxNumResults  types.Int64 `tfsdk:"max_num_results"`
+}
+
+// responseCodeInterpreterModel maps the code_interpreter tool configuration.
+type responseCodeInterpreterModel struct {
+	ContainerID types.String `tfsdk:"container_id"`
+	FileIDs     types.List   `tfsdk:"file_ids"`
+}
+
+// responseTool is a built-in tool enabled on a response.
+type responseTool struct {
+	Type              string   `json:"type"`
+	SearchContextSize string   `json:"search_context_size,omitempty"`
+	VectorStoreIDs    []string `json:"vector_store_ids,omitempty"`
+	MaxNumResults     *int64   `json:"max_num_results,omitempty"`
+	Container         any      `json:"container,omitempty"`
+}
+
+// responseToolContainer is the container created for the code_interpreter
+// tool when no container ID is given.
+type responseToolContainer struct {
+	Type    string   `json:"type"`
+	FileIDs []string `json:"file_ids,omitempty"`
+}
+
+// responseRequest is the body of a request creating a response.
+type responseRequest struct {
+	Model              string            `json:"model"`
+	Input              string            `json:"input"`
+	Instructions       string            `json:"instructions,omitempty"`
+	PreviousResponseID string            `json:"previous_response_id,omitempty"`
+	Temperature        *float64          `json:"temperature,omitempty"`
+	TopP               *float64          `json:"top_p,omitempty"`
+	MaxOutputTokens    *int64            `json:"max_output_tokens,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	Tools              []responseTool    `json:"tools,omitempty"`
+	Store              bool              `json:"store"`
+}
+
+// responseObject is a response returned by the Responses API. The output
+// items are kept as raw JSON, they are only decoded to extract the text.
+type responseObject struct {
+	ID        string            `json:"id"`
+	Status    string            `json:"status"`
+	CreatedAt int64             `json:"created_at"`
+	Output    []json.RawMessage `json:"output"`
+	Error     *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+	IncompleteDetails *struct {
+		Reason string `json:"reason"`
+	} `json:"incomplete_details"`
+}
+
+// outputText concatenates the text content of the message output items, the
+// same way the official SDKs compute output_text.
+func (r responseObject) outputText() string {
+	var text strings.Builder
+	for _, raw := range r.Output {
+		var item struct {
+			Type    string `json:"type"`
+			Content []struct {
+				Type string `json:"type"`
+				Text string `json:"text"`
+			} `json:"content"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil || item.Type != "message" {
+			continue
+		}
+		for _, content := range item.Content {
+			if content.Type == "output_text" {
+				text.WriteString(content.Text)
+			}
+		}
+	}
+
+	return text.String()
+}
+
+// Metadata returns the resource type name.
+func (r *responseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_response"
+}
+
+// Schema defines the schema for the resource.
+func (r *responseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a stored model response with the Responses API, optionally with the `web_search`, `file_search` and `code_interpreter` built-in tools. The response is only generated again when one of the arguments changes, and is deleted from OpenAI on destroy. Stored responses can be continued with `previous_response_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the response.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Model used to generate the response, such as `gpt-4o` or `o3`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input": schema.StringAttribute{
+				Description: "Text input to the model.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instructions": schema.StringAttribute{
+				Description: "System message inserted in the context of the model.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"previous_response_id": schema.StringAttribute{
+				Description: "ID of a previous response to continue the conversation from.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"temperature": schema.Float64Attribute{
+				Description: "Sampling temperature, between 0 and 2.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 2),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"top_p": schema.Float64Attribute{
+				Description: "Nucleus sampling probability mass, between 0 and 1.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"max_output_tokens": schema.Int64Attribute{
+				Description: "Maximum number of tokens generated for the response, including reasoning tokens.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the response.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  metadataValidators(),
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"web_search": schema.SingleNestedAttribute{
+				MarkdownDescription: "Enables the `web_search` tool, letting the model search the web. Set to `{}` to use the defaults.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"search_context_size": schema.StringAttribute{
+						MarkdownDescription: "Amount of context retrieved from the web, either `low`, `medium` or `high`. Defaults to `medium`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("low", "medium", "high"),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_search": schema.SingleNestedAttribute{
+				MarkdownDescription: "Enables the `file_search` tool, letting the model search the files of vector stores.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"vector_store_ids": schema.ListAttribute{
+						Description: "IDs of the vector stores to search.",
+						ElementType: types.StringType,
+						Required:    true,
+					},
+					"max_num_results": schema.Int64Attribute{
+						Description: "Maximum number of results to return, between 1 and 50.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 50),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"code_interpreter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Enables the `code_interpreter` tool, letting the model run Python code in a container. Set to `{}` to use the defaults.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"container_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the container to run the code in, such as an `openai_container`. Defaults to a container created for the response.",
+						Optional:            true,
+					},
+					"file_ids": schema.ListAttribute{
+						MarkdownDescription: "IDs of the files made available to the code. Conflicts with `container_id`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("container_id")),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the response, such as `completed` or `incomplete`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output_text": schema.StringAttribute{
+				Description: "Text output of the response.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output": schema.StringAttribute{
+				MarkdownDescription: "Output items of the response, JSON encoded. Use `jsondecode` to access tool calls and annotations.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the response.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the response, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *responseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the model is available to the API key.
+func (r *responseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.modifyPlanModel(ctx, req, resp)
+}
+
+// Create a new resource.
+func (r *responseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan responseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	responseRequest := responseRequest{
+		Model:              plan.Model.ValueString(),
+		Input:              plan.Input.ValueString(),
+		Instructions:       plan.Instructions.ValueString(),
+		PreviousResponseID: plan.PreviousResponseID.ValueString(),
+		Temperature:        plan.Temperature.ValueFloat64Pointer(),
+		TopP:               plan.TopP.ValueFloat64Pointer(),
+		MaxOutputTokens:    plan.MaxOutputTokens.ValueInt64Pointer(),
+		Store:              true,
+	}
+
+	if !plan.Metadata.IsNull() {
+		diags = plan.Metadata.ElementsAs(ctx, &responseRequest.Metadata, false)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	responseRequest.Tools, diags = plan.tools(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response responseObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/responses", responseRequest, &response)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error creating response", "Could not create response, unexpected error: ", err)
+		return
+	}
+
+	if response.Status == "failed" && response.Error != nil {
+		resp.Diagnostics.AddError(
+			"Error creating response",
+			fmt.Sprintf("Could not create response, the model failed with %s: %s", response.Error.Code, response.Error.Message),
+		)
+		// Clean up the failed response, it would not be tracked in state.
+		_ = r.client.doJSON(ctx, http.MethodDelete, "/responses/"+response.ID, nil, nil)
+		return
+	}
+
+	if response.Status == "incomplete" && response.IncompleteDetails != nil {
+		resp.Diagnostics.AddWarning(
+			"Incomplete response",
+			fmt.Sprintf("Response %s is incomplete: %s.", response.ID, response.IncompleteDetails.Reason),
+		)
+	}
+
+	// Wait for the response to be readable, the resources depending on it
+	// would otherwise fail with a 404 Not Found.
+	if err := r.client.waitReadable(ctx, "/responses/"+response.ID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Response not readable yet",
+			"The response "+response.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	diags = plan.refresh(response)
+	resp.Diagnostics.Append(diags...)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *responseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state responseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed response value from OpenAI
+	var response responseObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/responses/"+state.ID.ValueString(), nil, &response)
+	if removeNotFound(ctx, resp, err, "OpenAI response "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI response", "Could not read OpenAI response ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	diags = state.refresh(response)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *responseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires a replacement, there is nothing to send to OpenAI.
+	var plan responseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *responseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state responseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing response
+	err := r.client.doJSON(ctx, http.MethodDelete, "/responses/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI response", "Could not delete response, unexpected error: ", err)
+		return
+	}
+}
+
+// refresh populates the computed attributes from the response.
+func (m *responseResourceModel) refresh(response responseObject) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := json.Marshal(response.Output)
+	if err != nil {
+		diags.AddError(
+			"Error encoding response output",
+			"Could not encode the output of response "+response.ID+": "+err.Error(),
+		)
+		return diags
+	}
+
+	m.ID = types.StringValue(response.ID)
+	m.Status = types.StringValue(response.Status)
+	m.OutputText = types.StringValue(response.outputText())
+	m.Output = types.StringValue(string(output))
+	m.CreatedAt = types.Int64Value(response.CreatedAt)
+
+	return diags
+}
+
+// tools returns the built-in tools enabled on the response.
+func (m *responseResourceModel) tools(ctx context.Context) ([]responseTool, diag.Diagnostics) {
+	var tools []responseTool
+	var diags diag.Diagnostics
+
+	if m.WebSearch != nil {
+		tools = append(tools, responseTool{
+			Type:              "web_search",
+			SearchContextSize: m.WebSearch.SearchContextSize.ValueString(),
+		})
+	}
+
+	if m.FileSearch != nil {
+		tool := responseTool{
+			Type:          "file_search",
+			MaxNumResults: m.FileSearch.MaxNumResults.ValueInt64Pointer(),
+		}
+		diags.Append(m.FileSearch.VectorStoreIDs.ElementsAs(ctx, &tool.VectorStoreIDs, false)...)
+		tools = append(tools, tool)
+	}
+
+	if m.CodeInterpreter != nil {
+		var container any = m.CodeInterpreter.ContainerID.ValueString()
+		if m.CodeInterpreter.ContainerID.IsNull() {
+			auto := &responseToolContainer{Type: "auto"}
+			if !m.CodeInterpreter.FileIDs.IsNull() {
+				diags.Append(m.CodeInterpreter.FileIDs.ElementsAs(ctx, &auto.FileIDs, false)...)
+			}
+			container = auto
+		}
+		tools = append(tools, responseTool{
+			Type:      "code_interpreter",
+			Container: container,
+		})
+	}
+
+	return tools, diags
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultMaxRetries and defaultRetryMaxDelay are the number of retries of a
+// request and the maximum delay before a retry, unless set in the provider
+// configuration.
+const (
+	defaultMaxRetries    = 3
+	defaultRetryMaxDelay = 30 * time.Second
+)
+
+// retryBaseDelay is the delay before the first retry of a request, doubled
+// before each following retry.
+const retryBaseDelay = time.Second
+
+// retryTransport retries the requests failing with a rate limit error, and
+// the idempotent requests failing with a transient server error, waiting for the delay of the Retry-After header of
+// the response when there is one, with an exponential backoff otherwise.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	maxDelay   time.Duration
+}
+
+// newRetryTransport wraps next with the retries of the requests.
+func newRetryTransport(next http.RoundTripper, maxRetries int, maxDelay time.Duration) *retryTransport {
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		maxDelay:   maxDelay,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || retry >= t.maxRetries || !retryableResponse(req, resp) {
+			return resp, err
+		}
+
+		// The body of the request must be sent again, the requests whose body
+		// cannot be rewound are not retried.
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		delay := t.delay(resp, retry)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryableResponse reports whether the request failed with a transient
+// error. Running out of credits is also reported with a 429 Too Many Requests,
+// it is not retried as it does not resolve by itself. A request rejected by
+// the rate limits was not processed, but a server error may be returned after
+// the request was processed: only the idempotent requests are retried, a
+// retried POST could create the object twice.
+func retryableResponse(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return err == nil && !bytes.Contains(body, []byte("insufficient_quota"))
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return idempotentMethod(req.Method)
+	default:
+		return false
+	}
+}
+
+// idempotentMethod reports whether the requests with the method can be sent
+// again without side effects.
+func idempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// delay returns the delay before the given retry of a request, at most
+// maxDelay.
+func (t *retryTransport) delay(resp *http.Response, retry int) time.Duration {
+	delay, ok := retryAfter(resp.Header)
+	if !ok {
+		// Add a jitter, so the requests rate limited at once are not all
+		// retried at once.
+		delay = retryBaseDelay << retry
+		delay += time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	}
+
+	return min(delay, t.maxDelay)
+}
+
+// retryAfter returns the delay requested by the Retry-After header of a
+// response, either in seconds or as a date. OpenAI also sets the delay in
+// milliseconds in the retry-after-ms header.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	if ms, err := strconv.ParseFloat(header.Get("retry-after-ms"), 64); err == nil && ms >= 0 {
+		return time.Duration(ms * float64(time.Millisecond)), true
+	}
+
+	value := header.Get("Retry-After")
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// roundTripFunc is an http.RoundTripper calling the function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := map[string]struct {
+		method      string
+		statusCode  int
+		body        string
+		maxRetries  int
+		expected    int
+		expectedReq int32
+	}{
+		"rate limit": {
+			statusCode:  http.StatusTooManyRequests,
+			body:        `{"error":{"code":"rate_limit_exceeded"}}`,
+			maxRetries:  3,
+			expected:    http.StatusOK,
+			expectedReq: 3,
+		},
+		"server error": {
+			method:      http.MethodDelete,
+			statusCode:  http.StatusServiceUnavailable,
+			maxRetries:  3,
+			expected:    http.StatusOK,
+			expectedReq: 3,
+		},
+		"server error creating": {
+			statusCode:  http.StatusServiceUnavailable,
+			maxRetries:  3,
+			expected:    http.StatusServiceUnavailable,
+			expectedReq: 1,
+		},
+		"insufficient quota": {
+			statusCode:  http.StatusTooManyRequests,
+			body:        `{"error":{"code":"insufficient_quota"}}`,
+			maxRetries:  3,
+			expected:    http.StatusTooManyRequests,
+			expectedReq: 1,
+		},
+		"client error": {
+			statusCode:  http.StatusBadRequest,
+			maxRetries:  3,
+			expected:    http.StatusBadRequest,
+			expectedReq: 1,
+		},
+		"retries exhausted": {
+			method:      http.MethodGet,
+			statusCode:  http.StatusInternalServerError,
+			maxRetries:  1,
+			expected:    http.StatusInternalServerError,
+			expectedReq: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != "request" {
+					t.Errorf("expected the request body to be sent again, got %q", body)
+				}
+
+				// The first two requests fail.
+				if requests.Add(1) <= 2 {
+					w.Header().Set("retry-after-ms", "1")
+					w.WriteHeader(test.statusCode)
+					io.WriteString(w, test.body)
+					return
+				}
+			}))
+			defer server.Close()
+
+			method := test.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req, err := http.NewRequest(method, server.URL, strings.NewReader("request"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, test.maxRetries, time.Second)}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != test.expected {
+				t.Errorf("expected status %d, got %d", test.expected, resp.StatusCode)
+			}
+			if requests.Load() != test.expectedReq {
+				t.Errorf("expected %d requests, got %d", test.expectedReq, requests.Load())
+			}
+
+			// The body of the last response is still readable.
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.expected != http.StatusOK && string(body) != test.body {
+				t.Errorf("expected body %q, got %q", test.body, body)
+			}
+		})
+	}
+}
+
+func TestRetryTransportDelay(t *testing.T) {
+	transport := newRetryTransport(nil, 3, 5*time.Second)
+
+	tests := map[string]struct {
+		header http.Header
+		retry  int
+		min    time.Duration
+		max    time.Duration
+	}{
+		"retry after": {
+			header: http.Header{"Retry-After": {"2"}},
+			min:    2 * time.Second,
+			max:    2 * time.Second,
+		},
+		"retry after capped": {
+			header: http.Header{"Retry-After": {"60"}},
+			min:    5 * time.Second,
+			max:    5 * time.Second,
+		},
+		"first retry": {
+			header: http.Header{},
+			min:    retryBaseDelay,
+			max:    retryBaseDelay * 3 / 2,
+		},
+		"second retry": {
+			header: http.Header{},
+			retry:  1,
+			min:    2 * retryBaseDelay,
+			max:    3 * retryBaseDelay,
+		},
+		"backoff capped": {
+			header: http.Header{},
+			retry:  10,
+			min:    5 * time.Second,
+			max:    5 * time.Second,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			delay := transport.delay(&http.Response{Header: test.header}, test.retry)
+			if delay < test.min || delay > test.max {
+				t.Errorf("expected a delay between %s and %s, got %s", test.min, test.max, delay)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		header   http.Header
+		expected time.Duration
+		ok       bool
+	}{
+		"milliseconds": {
+			header:   http.Header{"Retry-After-Ms": {"1500"}, "Retry-After": {"2"}},
+			expected: 1500 * time.Millisecond,
+			ok:       true,
+		},
+		"seconds": {
+			header:   http.Header{"Retry-After": {"2"}},
+			expected: 2 * time.Second,
+			ok:       true,
+		},
+		"past date": {
+			header:   http.Header{"Retry-After": {"Wed, 21 Oct 2015 07:28:00 GMT"}},
+			expected: 0,
+			ok:       true,
+		},
+		"invalid": {
+			header: http.Header{"Retry-After": {"soon"}},
+		},
+		"missing": {
+			header: http.Header{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			delay, ok := retryAfter(test.header)
+			if ok != test.ok || delay != test.expected {
+				t.Errorf("expected (%s, %t), got (%s, %t)", test.expected, test.ok, delay, ok)
+			}
+		})
+	}
+}
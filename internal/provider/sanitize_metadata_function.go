@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &sanitizeMetadataFunction{}
+)
+
+// NewSanitizeMetadataFunction is a helper function to simplify the provider implementation.
+func NewSanitizeMetadataFunction() function.Function {
+	return &sanitizeMetadataFunction{}
+}
+
+// sanitizeMetadataFunction is the function implementation.
+type sanitizeMetadataFunction struct{}
+
+// Metadata returns the function name.
+func (f *sanitizeMetadataFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sanitize_metadata"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *sanitizeMetadataFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Fits metadata in the limits of OpenAI",
+		MarkdownDescription: fmt.Sprintf("Checks a metadata map against the limits of OpenAI: at most %d pairs, with keys of at most %d bytes and values of at most %d bytes. With the `error` mode, the pairs over the limits are reported as an error during plan. With the `truncate` mode, the keys and the values are truncated, without splitting a character, and the pairs after the first %d keys in lexical order are dropped.", metadataMaxPairs, metadataMaxKeyLength, metadataMaxValueLength, metadataMaxPairs),
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:        "metadata",
+				ElementType: types.StringType,
+				Description: "Metadata to check.",
+			},
+			function.StringParameter{
+				Name:                "mode",
+				MarkdownDescription: "Either `error` or `truncate`.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run checks or truncates the metadata.
+func (f *sanitizeMetadataFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var metadata map[string]string
+	var mode string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &metadata, &mode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	switch mode {
+	case "error":
+		var errs []string
+		if len(metadata) > metadataMaxPairs {
+			errs = append(errs, fmt.Sprintf("at most %d pairs are supported, got %d", metadataMaxPairs, len(metadata)))
+		}
+		for _, key := range keys {
+			if len(key) > metadataMaxKeyLength {
+				errs = append(errs, fmt.Sprintf("the key %q is longer than %d bytes", key, metadataMaxKeyLength))
+			}
+			if len(metadata[key]) > metadataMaxValueLength {
+				errs = append(errs, fmt.Sprintf("the value of %q is longer than %d bytes", key, metadataMaxValueLength))
+			}
+		}
+		if len(errs) > 0 {
+			resp.Diagnostics.AddArgumentError(0, "Invalid metadata", "The metadata exceeds the limits of OpenAI:\n  - "+strings.Join(errs, "\n  - "))
+			return
+		}
+	case "truncate":
+		sanitized := make(map[string]string, metadataMaxPairs)
+		for _, key := range keys[:min(len(keys), metadataMaxPairs)] {
+			truncated := truncateUTF8(key, metadataMaxKeyLength)
+			if _, ok := sanitized[truncated]; ok {
+				resp.Diagnostics.AddArgumentError(0, "Conflicting metadata keys", fmt.Sprintf("Several keys are truncated to %q, shorten them so they stay unique.", truncated))
+				return
+			}
+			sanitized[truncated] = truncateUTF8(metadata[key], metadataMaxValueLength)
+		}
+		metadata = sanitized
+	default:
+		resp.Diagnostics.AddArgumentError(1, "Invalid mode", fmt.Sprintf("mode must be error or truncate, got %q.", mode))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, metadata)...)
+}
+
+// truncateUTF8 returns the beginning of s of at most n bytes, without
+// splitting a character.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n]
+}
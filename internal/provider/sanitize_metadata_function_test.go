@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSanitizeMetadataFunction(t *testing.T) {
+	metadataValue := func(metadata map[string]string) types.Map {
+		elements := make(map[string]attr.Value, len(metadata))
+		for key, value := range metadata {
+			elements[key] = types.StringValue(value)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	tooMany := map[string]string{}
+	for i := 0; i < 20; i++ {
+		tooMany[fmt.Sprintf("key%02d", i)] = "value"
+	}
+	firstPairs := map[string]string{}
+	for i := 0; i < 16; i++ {
+		firstPairs[fmt.Sprintf("key%02d", i)] = "value"
+	}
+
+	tests := map[string]struct {
+		metadata map[string]string
+		mode     string
+		expected map[string]string
+		errors   []string
+	}{
+		"valid": {
+			metadata: map[string]string{"team": "platform"},
+			mode:     "error",
+			expected: map[string]string{"team": "platform"},
+		},
+		"too long": {
+			metadata: map[string]string{strings.Repeat("k", 65): "v", "team": strings.Repeat("v", 513)},
+			mode:     "error",
+			errors:   []string{"longer than 64 bytes", `the value of "team" is longer than 512 bytes`},
+		},
+		"too many pairs": {
+			metadata: tooMany,
+			mode:     "error",
+			errors:   []string{"at most 16 pairs are supported, got 20"},
+		},
+		"truncate": {
+			metadata: map[string]string{strings.Repeat("k", 70): "v", "team": strings.Repeat("é", 300)},
+			mode:     "truncate",
+			expected: map[string]string{strings.Repeat("k", 64): "v", "team": strings.Repeat("é", 256)},
+		},
+		"truncate pairs": {
+			metadata: tooMany,
+			mode:     "truncate",
+			expected: firstPairs,
+		},
+		"conflicting keys": {
+			metadata: map[string]string{strings.Repeat("k", 65): "a", strings.Repeat("k", 66): "b"},
+			mode:     "truncate",
+			errors:   []string{"Several keys are truncated to"},
+		},
+		"invalid mode": {
+			metadata: map[string]string{},
+			mode:     "drop",
+			errors:   []string{`mode must be error or truncate, got "drop".`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := runFunction(t, NewSanitizeMetadataFunction(), metadataValue(test.metadata), types.StringValue(test.mode))
+
+			if len(test.errors) > 0 {
+				if !diags.HasError() {
+					t.Fatal("expected an error")
+				}
+				for _, expected := range test.errors {
+					if !strings.Contains(diags[0].Detail(), expected) {
+						t.Errorf("expected the error to contain %q, got %q", expected, diags[0].Detail())
+					}
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if expected := metadataValue(test.expected); !result.Equal(expected) {
+				t.Errorf("expected %s, got %s", expected, result)
+			}
+		})
+	}
+}
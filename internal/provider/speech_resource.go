@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &speechResource{}
+	_ resource.ResourceWithConfigure      = &speechResource{}
+	_ resource.ResourceWithModifyPlan     = &speechResource{}
+	_ resource.ResourceWithValidateConfig = &speechResource{}
+)
+
+// speechFormats are the audio formats supported by the speech endpoint.
+var speechFormats = []string{"mp3", "opus", "aac", "flac", "wav", "pcm"}
+
+// NewSpeechResource is a helper function to simplify the provider implementation.
+func NewSpeechResource() resource.Resource {
+	return &speechResource{}
+}
+
+// speechResource is the resource implementation.
+type speechResource struct {
+	client *openaiClient
+}
+
+// speechResourceModel maps the resource schema data.
+type speechResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Input          types.String   `tfsdk:"input"`
+	Model          types.String   `tfsdk:"model"`
+	Voice          types.String   `tfsdk:"voice"`
+	Instructions   types.String   `tfsdk:"instructions"`
+	ResponseFormat types.String   `tfsdk:"response_format"`
+	Speed          types.Float64  `tfsdk:"speed"`
+	OutputPath     types.String   `tfsdk:"output_path"`
+	InputSHA256    types.String   `tfsdk:"input_sha256"`
+	OutputSHA256   types.String   `tfsdk:"output_sha256"`
+	Project        types.String   `tfsdk:"project"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// speechRequest is the body of a speech request.
+type speechRequest struct {
+	Input          string   `json:"input"`
+	Model          string   `json:"model"`
+	Voice          string   `json:"voice"`
+	Instructions   string   `json:"instructions,omitempty"`
+	ResponseFormat string   `json:"response_format,omitempty"`
+	Speed          *float64 `json:"speed,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *speechResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_speech"
+}
+
+// Schema defines the schema for the resource.
+func (r *speechResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Synthesizes speech from a text and writes the audio to `output_path`. The audio is only synthesized again when one of the arguments changes, or when the file is removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "SHA-256 checksum of the audio file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"input": schema.StringAttribute{
+				Description: "Text to synthesize, up to 4096 characters.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Text-to-speech model, such as `tts-1`, `tts-1-hd` or `gpt-4o-mini-tts`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"voice": schema.StringAttribute{
+				MarkdownDescription: "Voice used to synthesize the text, such as `alloy`, `echo`, `fable`, `onyx`, `nova` or `shimmer`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instructions": schema.StringAttribute{
+				MarkdownDescription: "Instructions on the tone, accent or pace of the voice. Not supported by `tts-1` and `tts-1-hd`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"response_format": schema.StringAttribute{
+				MarkdownDescription: "Audio format, either `mp3`, `opus`, `aac`, `flac`, `wav` or `pcm`. Defaults to `mp3`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(speechFormats...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"speed": schema.Float64Attribute{
+				MarkdownDescription: "Speed of the audio, from 0.25 to 4.0. Defaults to 1.0.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0.25, 4),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				Description: "Path to the file the audio is written to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the text the audio was synthesized from.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the audio file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the speech is billed to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *speechResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the model is available to the API key.
+func (r *speechResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.modifyPlanModel(ctx, req, resp)
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *speechResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config speechResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Input.IsUnknown() && len([]rune(config.Input.ValueString())) > 4096 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("input"),
+			"Input too long",
+			fmt.Sprintf("input must be at most 4096 characters, got %d.", len([]rune(config.Input.ValueString()))),
+		)
+	}
+
+	if model := config.Model.ValueString(); !config.Instructions.IsNull() && (model == "tts-1" || model == "tts-1-hd") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("instructions"),
+			"Unsupported instructions",
+			fmt.Sprintf("instructions are not supported by %s.", model),
+		)
+	}
+}
+
+// Create a new resource.
+func (r *speechResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan speechResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	var audio []byte
+	err := r.client.doJSON(ctx, http.MethodPost, "/audio/speech", speechRequest{
+		Input:          plan.Input.ValueString(),
+		Model:          plan.Model.ValueString(),
+		Voice:          plan.Voice.ValueString(),
+		Instructions:   plan.Instructions.ValueString(),
+		ResponseFormat: plan.ResponseFormat.ValueString(),
+		Speed:          plan.Speed.ValueFloat64Pointer(),
+	}, &audio)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error synthesizing speech", "Could not synthesize speech, unexpected error: ", err)
+		return
+	}
+
+	if err := writeLocalFile(plan.OutputPath.ValueString(), audio); err != nil {
+		resp.Diagnostics.AddError(
+			"Error saving audio",
+			"Could not synthesize speech, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	checksum := sha256Hex(audio)
+	plan.ID = types.StringValue(checksum)
+	plan.InputSHA256 = types.StringValue(sha256Hex([]byte(plan.Input.ValueString())))
+	plan.OutputSHA256 = types.StringValue(checksum)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *speechResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state speechResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Synthesize the speech again when the file has been removed.
+	if !localFileExists(state.OutputPath.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *speechResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires a replacement, there is nothing to send to OpenAI.
+	var plan speechResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *speechResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state speechResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := removeLocalFile(state.OutputPath.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting audio file",
+			"Could not delete audio file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
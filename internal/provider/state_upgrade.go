@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// renameAttributesStateUpgrader returns a state upgrader from the previous
+// version of a resource schema, renaming the top-level attributes of the state
+// from their old to their new name. An empty new name removes the attribute
+// from the state. The other attributes are kept as is, so the upgrader must be
+// registered for the version right before the current one.
+//
+// Bump the Version of the resource schema, and register the upgrader in the
+// UpgradeState method of the resource, when an attribute is renamed or
+// removed, see assistantResource.UpgradeState.
+func renameAttributesStateUpgrader(renames map[string]string) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"The resource state to upgrade is not stored as JSON. Please report this issue to the provider developers.",
+				)
+				return
+			}
+
+			var state map[string]json.RawMessage
+			if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"Could not decode the resource state, unexpected error: "+err.Error(),
+				)
+				return
+			}
+
+			for from, to := range renames {
+				value, ok := state[from]
+				if !ok {
+					continue
+				}
+
+				delete(state, from)
+				if to != "" {
+					state[to] = value
+				}
+			}
+
+			upgraded, err := json.Marshal(state)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					"Could not encode the resource state, unexpected error: "+err.Error(),
+				)
+				return
+			}
+
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestRenameAttributesStateUpgrader(t *testing.T) {
+	upgrader := renameAttributesStateUpgrader(map[string]string{
+		"enable_retrieval": "enable_file_search",
+		"last_updated":     "",
+		"missing":          "renamed",
+	})
+
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"asst_abc123","enable_retrieval":true,"last_updated":"Tuesday, 02-Jan-24 15:04:05 UTC"}`),
+		},
+	}
+	var resp resource.UpgradeStateResponse
+	upgrader.StateUpgrader(context.Background(), req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(resp.DynamicValue.JSON, &state); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]any{"id": "asst_abc123", "enable_file_search": true}
+	if !reflect.DeepEqual(state, expected) {
+		t.Errorf("expected %v, got %v", expected, state)
+	}
+}
+
+func TestRenameAttributesStateUpgraderWithoutJSON(t *testing.T) {
+	upgrader := renameAttributesStateUpgrader(map[string]string{"last_updated": ""})
+
+	var resp resource.UpgradeStateResponse
+	upgrader.StateUpgrader(context.Background(), resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{}}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a state not stored as JSON")
+	}
+}
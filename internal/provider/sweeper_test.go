@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccNamePrefix is the prefix of the names of the objects created by the
+// tests against an OpenAI account, the sweepers delete the objects left
+// behind with it.
+const testAccNamePrefix = "tf-acc-"
+
+// sweeperPageSize is the number of objects listed by page by the sweepers.
+var sweeperPageSize = 100
+
+func init() {
+	resource.AddTestSweepers("openai_assistant", &resource.Sweeper{
+		Name: "openai_assistant",
+		F:    sweepAssistants,
+	})
+	// The files of the assistants left behind are only deleted once the
+	// assistants are.
+	resource.AddTestSweepers("openai_file", &resource.Sweeper{
+		Name:         "openai_file",
+		Dependencies: []string{"openai_assistant"},
+		F:            sweepFiles,
+	})
+}
+
+// TestMain runs the sweepers instead of the tests with the -sweep flag, e.g.
+// go test ./internal/provider -v -sweep=openai, and -sweep-run=openai_file to
+// run only some of them. OpenAI has no regions, -sweep takes any single value.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sweepAssistants deletes the assistants named with testAccNamePrefix.
+func sweepAssistants(_ string) error {
+	client, err := testAccClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	var ids []string
+	query := url.Values{"limit": {strconv.Itoa(sweeperPageSize)}}
+	for {
+		var page struct {
+			Data    []assistantObject `json:"data"`
+			HasMore bool              `json:"has_more"`
+		}
+		if err := client.doJSON(ctx, http.MethodGet, "/assistants?"+query.Encode(), nil, &page); err != nil {
+			return fmt.Errorf("listing assistants: %w", err)
+		}
+
+		for _, assistant := range page.Data {
+			if strings.HasPrefix(assistant.Name, testAccNamePrefix) {
+				ids = append(ids, assistant.ID)
+			}
+		}
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		query.Set("after", page.Data[len(page.Data)-1].ID)
+	}
+
+	for _, id := range ids {
+		if err := client.doJSON(ctx, http.MethodDelete, "/assistants/"+id, nil, nil); err != nil && errorStatusCode(err) != http.StatusNotFound {
+			return fmt.Errorf("deleting assistant %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// sweepFiles deletes the files named with testAccNamePrefix.
+func sweepFiles(_ string) error {
+	client, err := testAccClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	var ids []string
+	query := url.Values{"limit": {strconv.Itoa(sweeperPageSize)}, "order": {"asc"}}
+	for {
+		var page struct {
+			Data    []fileObject `json:"data"`
+			HasMore bool         `json:"has_more"`
+		}
+		if err := client.doJSON(ctx, http.MethodGet, "/files?"+query.Encode(), nil, &page); err != nil {
+			return fmt.Errorf("listing files: %w", err)
+		}
+
+		for _, file := range page.Data {
+			if strings.HasPrefix(file.Filename, testAccNamePrefix) {
+				ids = append(ids, file.ID)
+			}
+		}
+
+		if !page.HasMore || len(page.Data) == 0 {
+			break
+		}
+		query.Set("after", page.Data[len(page.Data)-1].ID)
+	}
+
+	for _, id := range ids {
+		if err := client.doJSON(ctx, http.MethodDelete, "/files/"+id, nil, nil); err != nil && errorStatusCode(err) != http.StatusNotFound {
+			return fmt.Errorf("deleting file %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func TestSweepers(t *testing.T) {
+	server := newMockOpenAI(t)
+	t.Setenv("OPENAI_API_KEY", "sk-test")
+	t.Setenv("OPENAI_BASE_URL", server.URL)
+
+	// List one object by page to sweep several pages.
+	sweeperPageSize = 1
+	t.Cleanup(func() { sweeperPageSize = 100 })
+
+	client := server.client()
+	ctx := context.Background()
+	for _, name := range []string{testAccNamePrefix + "assistant", "production", testAccNamePrefix + "other"} {
+		if err := client.doJSON(ctx, http.MethodPost, "/assistants", assistantRequest{Name: &name, Model: "gpt-4o"}, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.uploadFile(ctx, name+".jsonl", "batch", contentSection([]byte("{}\n"))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, sweep := range []func(string) error{sweepAssistants, sweepFiles} {
+		if err := sweep(""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(server.assistants) != 1 || len(server.files) != 1 {
+		t.Fatalf("expected only the objects without the prefix to be kept, got %v and %v", server.assistants, server.files)
+	}
+	for _, assistant := range server.assistants {
+		if assistant.Name != "production" {
+			t.Errorf("expected the production assistant to be kept, got %s", assistant.Name)
+		}
+	}
+	for _, file := range server.files {
+		if file.Filename != "production.jsonl" {
+			t.Errorf("expected the production file to be kept, got %s", file.Filename)
+		}
+	}
+}
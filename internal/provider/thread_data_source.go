@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &threadDataSource{}
+	_ datasource.DataSourceWithConfigure = &threadDataSource{}
+)
+
+// NewThreadDataSource is a helper function to simplify the provider implementation.
+func NewThreadDataSource() datasource.DataSource {
+	return &threadDataSource{}
+}
+
+// threadDataSource is the data source implementation.
+type threadDataSource struct {
+	client *openaiClient
+}
+
+// threadDataSourceModel maps the data source schema data.
+type threadDataSourceModel struct {
+	ID            types.String        `tfsdk:"id"`
+	ToolResources *toolResourcesModel `tfsdk:"tool_resources"`
+	Metadata      types.Map           `tfsdk:"metadata"`
+	CreatedAt     types.Int64         `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *threadDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_thread"
+}
+
+// Schema defines the schema for the data source.
+func (d *threadDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches an OpenAI thread.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the thread.",
+				Required:    true,
+			},
+			"tool_resources": toolResourcesDataSourceSchema("Resources made available to the tools of the assistants running on the thread."),
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the thread.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the thread.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *threadDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *threadDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data threadDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var thread threadObject
+	err := d.client.doJSON(ctx, http.MethodGet, "/threads/"+data.ID.ValueString(), nil, &thread)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to read OpenAI thread", "", err)
+		return
+	}
+
+	data.ID = types.StringValue(thread.ID)
+	data.CreatedAt = types.Int64Value(thread.CreatedAt)
+
+	data.ToolResources, diags = toolResourcesDataFromAPI(ctx, thread.ToolResources)
+	resp.Diagnostics.Append(diags...)
+
+	if thread.Metadata == nil {
+		thread.Metadata = map[string]string{}
+	}
+	data.Metadata, diags = types.MapValueFrom(ctx, types.StringType, thread.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
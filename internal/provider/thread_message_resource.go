@@ -0,0 +1,451 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &threadMessageResource{}
+	_ resource.ResourceWithConfigure   = &threadMessageResource{}
+	_ resource.ResourceWithImportState = &threadMessageResource{}
+)
+
+// NewThreadMessageResource is a helper function to simplify the provider implementation.
+func NewThreadMessageResource() resource.Resource {
+	return &threadMessageResource{}
+}
+
+// threadMessageResource is the resource implementation.
+type threadMessageResource struct {
+	client *openaiClient
+}
+
+// threadMessageResourceModel maps the resource schema data.
+type threadMessageResourceModel struct {
+	ID          types.String                   `tfsdk:"id"`
+	ThreadID    types.String                   `tfsdk:"thread_id"`
+	Role        types.String                   `tfsdk:"role"`
+	Content     types.String                   `tfsdk:"content"`
+	Attachments []threadMessageAttachmentModel `tfsdk:"attachments"`
+	Metadata    types.Map                      `tfsdk:"metadata"`
+	CreatedAt   types.Int64                    `tfsdk:"created_at"`
+	Project     types.String                   `tfsdk:"project"`
+	Timeouts    timeouts.Value                 `tfsdk:"timeouts"`
+}
+
+// threadMessageAttachmentModel maps a file attached to a message.
+type threadMessageAttachmentModel struct {
+	FileID types.String `tfsdk:"file_id"`
+	Tools  types.List   `tfsdk:"tools"`
+}
+
+// threadMessageAttachment is a file attached to a message, with the tools it
+// is added to.
+type threadMessageAttachment struct {
+	FileID string `json:"file_id"`
+	Tools  []struct {
+		Type string `json:"type"`
+	} `json:"tools"`
+}
+
+// threadMessageRequest is the body of a request creating or updating a
+// message.
+type threadMessageRequest struct {
+	Role        string                    `json:"role,omitempty"`
+	Content     string                    `json:"content,omitempty"`
+	Attachments []threadMessageAttachment `json:"attachments,omitempty"`
+	Metadata    map[string]string         `json:"metadata"`
+}
+
+// threadMessageObject is a message returned by the Assistants API.
+type threadMessageObject struct {
+	ID        string `json:"id"`
+	ThreadID  string `json:"thread_id"`
+	Role      string `json:"role"`
+	CreatedAt int64  `json:"created_at"`
+	Content   []struct {
+		Type string `json:"type"`
+		Text struct {
+			Value string `json:"value"`
+		} `json:"text"`
+	} `json:"content"`
+	Attachments []threadMessageAttachment `json:"attachments"`
+	Metadata    map[string]string         `json:"metadata"`
+}
+
+// text concatenates the text content of the message.
+func (m threadMessageObject) text() string {
+	var text strings.Builder
+	for _, content := range m.Content {
+		if content.Type == "text" {
+			text.WriteString(content.Text.Value)
+		}
+	}
+
+	return text.String()
+}
+
+// Metadata returns the resource type name.
+func (r *threadMessageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_thread_message"
+}
+
+// Schema defines the schema for the resource.
+func (r *threadMessageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a message of an OpenAI thread, optionally with files attached for the code_interpreter or file_search tools.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the message.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"thread_id": schema.StringAttribute{
+				Description: "ID of the thread the message belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the author of the message, either `user` or `assistant`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "assistant"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Content of the message.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attachments": schema.ListNestedAttribute{
+				Description: "Files attached to the message.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_id": schema.StringAttribute{
+							Description: "ID of the file to attach.",
+							Required:    true,
+						},
+						"tools": schema.ListAttribute{
+							MarkdownDescription: "Tools the file is added to, `code_interpreter` and/or `file_search`.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.OneOf("code_interpreter", "file_search")),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the message.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  metadataValidators(),
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the message.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the thread, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *threadMessageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create a new resource.
+func (r *threadMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan threadMessageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	messageRequest := threadMessageRequest{
+		Role:    plan.Role.ValueString(),
+		Content: plan.Content.ValueString(),
+	}
+
+	for _, attachment := range plan.Attachments {
+		var tools []string
+		diags = attachment.Tools.ElementsAs(ctx, &tools, false)
+		resp.Diagnostics.Append(diags...)
+
+		messageAttachment := threadMessageAttachment{FileID: attachment.FileID.ValueString()}
+		for _, tool := range tools {
+			messageAttachment.Tools = append(messageAttachment.Tools, struct {
+				Type string `json:"type"`
+			}{Type: tool})
+		}
+		messageRequest.Attachments = append(messageRequest.Attachments, messageAttachment)
+	}
+
+	messageRequest.Metadata, diags = stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var message threadMessageObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/threads/"+plan.ThreadID.ValueString()+"/messages", messageRequest, &message)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating thread message", "Could not create thread message, unexpected error: ", err)
+		return
+	}
+
+	// Wait for the message to be readable, the resources depending on it
+	// would otherwise fail with a 404 Not Found.
+	if err := r.client.waitReadable(ctx, "/threads/"+plan.ThreadID.ValueString()+"/messages/"+message.ID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Message not readable yet",
+			"The message "+message.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(message.ID)
+	plan.CreatedAt = types.Int64Value(message.CreatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *threadMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state threadMessageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed message value from OpenAI
+	var message threadMessageObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/threads/"+state.ThreadID.ValueString()+"/messages/"+state.ID.ValueString(), nil, &message)
+	if removeNotFound(ctx, resp, err, "OpenAI thread message "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI thread message", "Could not read OpenAI thread message ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	diags = state.refresh(ctx, message)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *threadMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan threadMessageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	// Only the metadata can be updated, any other change replaces the message.
+	metadata, diags := stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.doJSON(ctx, http.MethodPost, "/threads/"+plan.ThreadID.ValueString()+"/messages/"+plan.ID.ValueString(), threadMessageRequest{
+		Metadata: metadata,
+	}, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating OpenAI thread message", "Could not update thread message, unexpected error: ", err)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *threadMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state threadMessageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing message
+	err := r.client.doJSON(ctx, http.MethodDelete, "/threads/"+state.ThreadID.ValueString()+"/messages/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI thread message", "Could not delete thread message, unexpected error: ", err)
+		return
+	}
+}
+
+// ImportState imports a message from an ID in the thread_id/message_id format.
+func (r *threadMessageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	threadID, messageID, ok := strings.Cut(req.ID, "/")
+	if !ok || threadID == "" || messageID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: thread_id/message_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("thread_id"), threadID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), messageID)...)
+}
+
+// refresh populates the model from the message returned by the API.
+func (m *threadMessageResourceModel) refresh(ctx context.Context, message threadMessageObject) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(message.ID)
+	m.ThreadID = types.StringValue(message.ThreadID)
+	m.Role = types.StringValue(message.Role)
+	m.Content = types.StringValue(message.text())
+	m.CreatedAt = types.Int64Value(message.CreatedAt)
+
+	if len(message.Attachments) > 0 || m.Attachments != nil {
+		m.Attachments = []threadMessageAttachmentModel{}
+	}
+	for _, attachment := range message.Attachments {
+		var tools []string
+		for _, tool := range attachment.Tools {
+			tools = append(tools, tool.Type)
+		}
+		toolsValue, d := types.ListValueFrom(ctx, types.StringType, tools)
+		diags.Append(d...)
+
+		m.Attachments = append(m.Attachments, threadMessageAttachmentModel{
+			FileID: types.StringValue(attachment.FileID),
+			Tools:  toolsValue,
+		})
+	}
+
+	metadata, d := stringMapFromAPI(ctx, m.Metadata, message.Metadata)
+	diags.Append(d...)
+	m.Metadata = metadata
+
+	return diags
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &threadResource{}
+	_ resource.ResourceWithConfigure   = &threadResource{}
+	_ resource.ResourceWithImportState = &threadResource{}
+)
+
+// NewThreadResource is a helper function to simplify the provider implementation.
+func NewThreadResource() resource.Resource {
+	return &threadResource{}
+}
+
+// threadResource is the resource implementation.
+type threadResource struct {
+	client *openaiClient
+}
+
+// threadResourceModel maps the resource schema data.
+type threadResourceModel struct {
+	ID            types.String        `tfsdk:"id"`
+	Messages      []threadMessageItem `tfsdk:"messages"`
+	ToolResources *toolResourcesModel `tfsdk:"tool_resources"`
+	Metadata      types.Map           `tfsdk:"metadata"`
+	CreatedAt     types.Int64         `tfsdk:"created_at"`
+	Project       types.String        `tfsdk:"project"`
+	Timeouts      timeouts.Value      `tfsdk:"timeouts"`
+}
+
+// threadMessageItem maps an initial message of the thread.
+type threadMessageItem struct {
+	Role    types.String `tfsdk:"role"`
+	Content types.String `tfsdk:"content"`
+}
+
+// threadMessage is a message sent to the threads endpoints.
+type threadMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// threadRequest is the body of a request creating or updating a thread.
+type threadRequest struct {
+	Messages      []threadMessage   `json:"messages,omitempty"`
+	ToolResources *toolResources    `json:"tool_resources,omitempty"`
+	Metadata      map[string]string `json:"metadata"`
+}
+
+// threadObject is a thread returned by the Assistants API.
+type threadObject struct {
+	ID            string            `json:"id"`
+	CreatedAt     int64             `json:"created_at"`
+	ToolResources *toolResources    `json:"tool_resources"`
+	Metadata      map[string]string `json:"metadata"`
+}
+
+// Metadata returns the resource type name.
+func (r *threadResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_thread"
+}
+
+// Schema defines the schema for the resource.
+func (r *threadResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides an OpenAI thread resource, a conversation between an assistant and a user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the thread.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"messages": schema.ListNestedAttribute{
+				MarkdownDescription: "Initial messages of the thread. Use `openai_thread_message` to manage messages individually.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the author of the message, either `user` or `assistant`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("user", "assistant"),
+							},
+						},
+						"content": schema.StringAttribute{
+							Description: "Content of the message.",
+							Required:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"tool_resources": toolResourcesSchema("Resources made available to the tools of the assistants running on the thread."),
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the thread.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  metadataValidators(),
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the thread.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the thread, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *threadResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create a new resource.
+func (r *threadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan threadResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	threadRequest := threadRequest{}
+	for _, message := range plan.Messages {
+		threadRequest.Messages = append(threadRequest.Messages, threadMessage{
+			Role:    message.Role.ValueString(),
+			Content: message.Content.ValueString(),
+		})
+	}
+
+	threadRequest.ToolResources, diags = toolResourcesValue(ctx, plan.ToolResources, false)
+	resp.Diagnostics.Append(diags...)
+
+	threadRequest.Metadata, diags = stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var thread threadObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/threads", threadRequest, &thread)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating thread", "Could not create thread, unexpected error: ", err)
+		return
+	}
+
+	// Wait for the thread to be readable, the resources depending on it
+	// would otherwise fail with a 404 Not Found.
+	if err := r.client.waitReadable(ctx, "/threads/"+thread.ID); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Thread not readable yet",
+			"The thread "+thread.ID+" was created but could not be read back: "+err.Error(),
+		)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(thread.ID)
+	plan.CreatedAt = types.Int64Value(thread.CreatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *threadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state threadResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed thread value from OpenAI
+	var thread threadObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/threads/"+state.ID.ValueString(), nil, &thread)
+	if removeNotFound(ctx, resp, err, "OpenAI thread "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI thread", "Could not read OpenAI thread ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	state.CreatedAt = types.Int64Value(thread.CreatedAt)
+
+	state.ToolResources, diags = toolResourcesFromAPI(ctx, state.ToolResources, thread.ToolResources)
+	resp.Diagnostics.Append(diags...)
+
+	state.Metadata, diags = stringMapFromAPI(ctx, state.Metadata, thread.Metadata)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *threadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan threadResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	// Only the tool resources and the metadata can be updated, a change of the
+	// messages replaces the thread.
+	threadRequest := threadRequest{}
+
+	threadRequest.ToolResources, diags = toolResourcesValue(ctx, plan.ToolResources, true)
+	resp.Diagnostics.Append(diags...)
+
+	threadRequest.Metadata, diags = stringMapValue(ctx, plan.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var thread threadObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/threads/"+plan.ID.ValueString(), threadRequest, &thread)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating OpenAI thread", "Could not update thread, unexpected error: ", err)
+		return
+	}
+
+	plan.CreatedAt = types.Int64Value(thread.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *threadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state threadResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing thread
+	err := r.client.doJSON(ctx, http.MethodDelete, "/threads/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI thread", "Could not delete thread, unexpected error: ", err)
+		return
+	}
+}
+
+func (r *threadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccThreadResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed("openai_thread", "/threads/"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreadResourceConfig("tests"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openai_thread.test", "id"),
+					resource.TestCheckResourceAttr("openai_thread.test", "messages.#", "1"),
+					resource.TestCheckResourceAttr("openai_thread.test", "metadata.suite", "tests"),
+				),
+			},
+			{
+				// The messages are only sent on create.
+				ResourceName:            "openai_thread.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"messages"},
+			},
+			{
+				Config: testAccThreadResourceConfig("updated tests"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_thread.test", "metadata.suite", "updated tests"),
+				),
+			},
+		},
+	})
+}
+
+func testAccThreadResourceConfig(suite string) string {
+	return `
+resource "openai_thread" "test" {
+  messages = [{
+    role    = "user"
+    content = "Hello from the tests of the provider."
+  }]
+  metadata = {
+    suite = "` + suite + `"
+  }
+}
+`
+}
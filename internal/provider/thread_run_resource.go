@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &threadRunResource{}
+	_ resource.ResourceWithConfigure  = &threadRunResource{}
+	_ resource.ResourceWithModifyPlan = &threadRunResource{}
+)
+
+// threadRunPollInterval is the interval between two checks of the status of a
+// run.
+const threadRunPollInterval = time.Second
+
+// threadRunTimeout is the default create timeout of a run, which may call
+// tools for a while before completing.
+const threadRunTimeout = time.Hour
+
+// NewThreadRunResource is a helper function to simplify the provider implementation.
+func NewThreadRunResource() resource.Resource {
+	return &threadRunResource{}
+}
+
+// threadRunResource is the resource implementation.
+type threadRunResource struct {
+	client *openaiClient
+}
+
+// threadRunResourceModel maps the resource schema data.
+type threadRunResourceModel struct {
+	ID                     types.String            `tfsdk:"id"`
+	ThreadID               types.String            `tfsdk:"thread_id"`
+	AssistantID            types.String            `tfsdk:"assistant_id"`
+	Model                  types.String            `tfsdk:"model"`
+	Instructions           types.String            `tfsdk:"instructions"`
+	AdditionalInstructions types.String            `tfsdk:"additional_instructions"`
+	Metadata               types.Map               `tfsdk:"metadata"`
+	Triggers               types.Map               `tfsdk:"triggers"`
+	Status                 types.String            `tfsdk:"status"`
+	Messages               []threadRunMessageModel `tfsdk:"messages"`
+	Project                types.String            `tfsdk:"project"`
+	Timeouts               timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// threadRunMessageModel maps a message added to the thread by the run.
+type threadRunMessageModel struct {
+	ID      types.String `tfsdk:"id"`
+	Role    types.String `tfsdk:"role"`
+	Content types.String `tfsdk:"content"`
+}
+
+// threadRunRequest is the body of a request creating a run.
+type threadRunRequest struct {
+	AssistantID            string            `json:"assistant_id"`
+	Model                  string            `json:"model,omitempty"`
+	Instructions           string            `json:"instructions,omitempty"`
+	AdditionalInstructions string            `json:"additional_instructions,omitempty"`
+	Metadata               map[string]string `json:"metadata,omitempty"`
+}
+
+// threadRunObject is a run returned by the Assistants API.
+type threadRunObject struct {
+	ID        string `json:"id"`
+	ThreadID  string `json:"thread_id"`
+	Status    string `json:"status"`
+	LastError *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"last_error"`
+	IncompleteDetails *struct {
+		Reason string `json:"reason"`
+	} `json:"incomplete_details"`
+}
+
+// Metadata returns the resource type name.
+func (r *threadRunResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_thread_run"
+}
+
+// Schema defines the schema for the resource.
+func (r *threadRunResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs an assistant on a thread once and waits for the run to complete, e.g. to smoke test a freshly provisioned assistant. Runs requiring tool outputs from the caller, such as function calls, fail as they cannot be answered during an apply. The assistant is only run again when one of the arguments changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the run.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"thread_id": schema.StringAttribute{
+				Description: "ID of the thread to run.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assistant_id": schema.StringAttribute{
+				Description: "ID of the assistant to run.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				Description: "Model overriding the model of the assistant for this run.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instructions": schema.StringAttribute{
+				Description: "Instructions overriding the instructions of the assistant for this run.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"additional_instructions": schema.StringAttribute{
+				Description: "Instructions appended to the instructions of the assistant for this run.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the run.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  metadataValidators(),
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that run the assistant again when changed, e.g. the `id` of the assistant resource.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Final status of the run, `completed` or `incomplete`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"messages": schema.ListNestedAttribute{
+				Description: "Messages added to the thread by the run, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the message.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the author of the message.",
+							Computed:    true,
+						},
+						"content": schema.StringAttribute{
+							Description: "Text content of the message.",
+							Computed:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the thread, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *threadRunResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the model is available to the API key.
+func (r *threadRunResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.modifyPlanModel(ctx, req, resp)
+}
+
+// Create a new resource.
+func (r *threadRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan threadRunResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, threadRunTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	runRequest := threadRunRequest{
+		AssistantID:            plan.AssistantID.ValueString(),
+		Model:                  plan.Model.ValueString(),
+		Instructions:           plan.Instructions.ValueString(),
+		AdditionalInstructions: plan.AdditionalInstructions.ValueString(),
+	}
+
+	if !plan.Metadata.IsNull() {
+		diags = plan.Metadata.ElementsAs(ctx, &runRequest.Metadata, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	runsPath := "/threads/" + plan.ThreadID.ValueString() + "/runs"
+
+	var run threadRunObject
+	err := r.client.doJSON(ctx, http.MethodPost, runsPath, runRequest, &run)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error creating thread run", "Could not create thread run, unexpected error: ", err)
+		return
+	}
+
+	run, err = r.client.waitThreadRun(ctx, run)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error running thread", "Could not complete thread run "+run.ID+": ", err)
+		return
+	}
+
+	if run.Status == "incomplete" && run.IncompleteDetails != nil {
+		resp.Diagnostics.AddWarning(
+			"Incomplete thread run",
+			fmt.Sprintf("Thread run %s is incomplete: %s.", run.ID, run.IncompleteDetails.Reason),
+		)
+	}
+
+	var messages struct {
+		Data []threadMessageObject `json:"data"`
+	}
+	query := url.Values{"run_id": {run.ID}, "order": {"asc"}, "limit": {"100"}}
+	err = r.client.doJSON(ctx, http.MethodGet, "/threads/"+plan.ThreadID.ValueString()+"/messages?"+query.Encode(), nil, &messages)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reading thread run messages", "Could not read the messages of thread run "+run.ID+": ", err)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(run.ID)
+	plan.Status = types.StringValue(run.Status)
+	plan.Messages = []threadRunMessageModel{}
+	for _, message := range messages.Data {
+		plan.Messages = append(plan.Messages, threadRunMessageModel{
+			ID:      types.StringValue(message.ID),
+			Role:    types.StringValue(message.Role),
+			Content: types.StringValue(message.text()),
+		})
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+//
+// A finished run never changes, the previous output is kept as is in state.
+func (r *threadRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state threadRunResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *threadRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires a replacement, there is nothing to send to OpenAI.
+	var plan threadRunResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *threadRunResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Runs cannot be deleted, they are removed with their thread. Removing the
+	// resource from state is enough.
+}
+
+// waitThreadRun polls the run until it reaches a terminal status. Runs
+// requiring an action are cancelled and reported as an error, as tool outputs
+// cannot be submitted during an apply.
+func (c *openaiClient) waitThreadRun(ctx context.Context, run threadRunObject) (threadRunObject, error) {
+	ctx = withFreshReads(ctx)
+
+	runPath := "/threads/" + run.ThreadID + "/runs/" + run.ID
+
+	for {
+		switch run.Status {
+		case "completed", "incomplete":
+			return run, nil
+		case "failed":
+			if run.LastError != nil {
+				return run, fmt.Errorf("run failed with %s: %s", run.LastError.Code, run.LastError.Message)
+			}
+			return run, fmt.Errorf("run failed")
+		case "cancelled", "expired":
+			return run, fmt.Errorf("run %s", run.Status)
+		case "requires_action":
+			_ = c.doJSON(ctx, http.MethodPost, runPath+"/cancel", nil, nil)
+			return run, fmt.Errorf("run requires tool outputs, which cannot be submitted by Terraform; remove the function tools of the assistant")
+		}
+
+		select {
+		case <-ctx.Done():
+			return run, ctx.Err()
+		case <-time.After(threadRunPollInterval):
+		}
+
+		// The first polls can hit a replica not aware of the new run yet.
+		err := retryNotFound(ctx, func(ctx context.Context) error {
+			return c.doJSON(ctx, http.MethodGet, runPath, nil, &run)
+		})
+		if err != nil {
+			return run, err
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// throttleTransport spaces out the requests sent to OpenAI, so the changes
+// made to many objects at once stay under a requests per minute budget
+// instead of failing with rate limit errors.
+type throttleTransport struct {
+	next     http.RoundTripper
+	interval time.Duration
+
+	mu       sync.Mutex
+	nextSlot time.Time
+}
+
+// newThrottleTransport wraps next with the throttling of the requests to
+// requestsPerMinute. The requests are not throttled when requestsPerMinute
+// is 0.
+func newThrottleTransport(next http.RoundTripper, requestsPerMinute int) http.RoundTripper {
+	if requestsPerMinute <= 0 {
+		return next
+	}
+
+	return &throttleTransport{
+		next:     next,
+		interval: time.Minute / time.Duration(requestsPerMinute),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Reserve the next slot, the concurrent requests wait for consecutive
+	// slots.
+	t.mu.Lock()
+	now := time.Now()
+	slot := t.nextSlot
+	if slot.Before(now) {
+		slot = now
+	}
+	t.nextSlot = slot.Add(t.interval)
+	t.mu.Unlock()
+
+	if wait := slot.Sub(now); wait > 0 {
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestThrottleTransport(t *testing.T) {
+	var next http.RoundTripper = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	})
+
+	if transport := newThrottleTransport(next, 0); transport == nil {
+		t.Fatal("expected the next transport without a requests per minute budget")
+	} else if _, ok := transport.(*throttleTransport); ok {
+		t.Error("expected the requests not to be throttled without a requests per minute budget")
+	}
+
+	// 6000 requests per minute are one request every 10ms.
+	transport := newThrottleTransport(next, 6000)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://api.openai.com/v1/models", nil)
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("expected 5 requests to take at least 40ms, took %s", elapsed)
+	}
+}
+
+func TestThrottleTransportCanceled(t *testing.T) {
+	transport := newThrottleTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	}), 1)
+
+	// The first request takes the current slot, the second waits a minute.
+	req, _ := http.NewRequest(http.MethodGet, "https://api.openai.com/v1/models", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := transport.RoundTrip(req.WithContext(ctx)); err != context.DeadlineExceeded {
+		t.Errorf("expected the deadline to be exceeded, got %v", err)
+	}
+}
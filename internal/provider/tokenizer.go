@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkoukk/tiktoken-go"
+	tiktoken_loader "github.com/pkoukk/tiktoken-go-loader"
+)
+
+// o200kModelPrefixes are the prefixes of the models tokenized with the
+// o200k_base encoding which are not known to tiktoken-go yet.
+var o200kModelPrefixes = []string{"gpt-5", "gpt-4.1", "gpt-4.5", "gpt-4o", "chatgpt-4o", "o1", "o3", "o4"}
+
+// tokenizers caches the tokenizers by encoding, building one loads the whole
+// vocabulary of the encoding.
+var (
+	tokenizersMu sync.Mutex
+	tokenizers   = map[string]*tiktoken.Tiktoken{}
+)
+
+// modelEncoding returns the name of the encoding the model tokenizes text
+// with. A fine-tuned model uses the encoding of its base model.
+func modelEncoding(model string) (string, error) {
+	if base, ok := strings.CutPrefix(model, "ft:"); ok {
+		model, _, _ = strings.Cut(base, ":")
+	}
+
+	for _, prefix := range o200kModelPrefixes {
+		if strings.HasPrefix(model, prefix) {
+			return tiktoken.MODEL_O200K_BASE, nil
+		}
+	}
+
+	if encoding, ok := tiktoken.MODEL_TO_ENCODING[model]; ok {
+		return encoding, nil
+	}
+	for prefix, encoding := range tiktoken.MODEL_PREFIX_TO_ENCODING {
+		if strings.HasPrefix(model, prefix) {
+			return encoding, nil
+		}
+	}
+
+	return "", fmt.Errorf("the tokenizer of the model %q is unknown, use a model such as gpt-4o, gpt-4.1, o3 or text-embedding-3-small", model)
+}
+
+// modelTokenizer returns the tokenizer of the model.
+func modelTokenizer(model string) (*tiktoken.Tiktoken, error) {
+	encoding, err := modelEncoding(model)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodingTokenizer(encoding)
+}
+
+// encodingTokenizer returns the tokenizer of the encoding. The vocabularies
+// ship with the provider, no request is sent to download them.
+func encodingTokenizer(encoding string) (*tiktoken.Tiktoken, error) {
+	tokenizersMu.Lock()
+	defer tokenizersMu.Unlock()
+
+	if tokenizer, ok := tokenizers[encoding]; ok {
+		return tokenizer, nil
+	}
+
+	tiktoken.SetBpeLoader(tiktoken_loader.NewOfflineLoader())
+	tokenizer, err := tiktoken.GetEncoding(encoding)
+	if err != nil {
+		return nil, err
+	}
+	tokenizers[encoding] = tokenizer
+
+	return tokenizer, nil
+}
+
+// countTokens returns the number of tokens of text for the model. Special
+// tokens such as <|endoftext|> are counted as plain text, like the API does
+// for the content of the messages.
+func countTokens(model, text string) (int, error) {
+	tokenizer, err := modelTokenizer(model)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(tokenizer.EncodeOrdinary(text)), nil
+}
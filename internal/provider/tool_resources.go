@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// toolResourcesModel maps the resources made available to the tools of an
+// assistant or a thread.
+type toolResourcesModel struct {
+	CodeInterpreter *codeInterpreterResourcesModel `tfsdk:"code_interpreter"`
+	FileSearch      *fileSearchResourcesModel      `tfsdk:"file_search"`
+}
+
+// codeInterpreterResourcesModel maps the resources of the code_interpreter tool.
+type codeInterpreterResourcesModel struct {
+	FileIDs types.List `tfsdk:"file_ids"`
+}
+
+// fileSearchResourcesModel maps the resources of the file_search tool.
+type fileSearchResourcesModel struct {
+	VectorStoreIDs types.List `tfsdk:"vector_store_ids"`
+}
+
+// toolResources is the tool_resources object of the Assistants API.
+type toolResources struct {
+	CodeInterpreter *struct {
+		FileIDs []string `json:"file_ids"`
+	} `json:"code_interpreter,omitempty"`
+	FileSearch *struct {
+		VectorStoreIDs []string `json:"vector_store_ids"`
+	} `json:"file_search,omitempty"`
+}
+
+// toolResourcesSchema returns the schema of the tool_resources attribute of
+// resources.
+func toolResourcesSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"code_interpreter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Resources of the `code_interpreter` tool.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"file_ids": schema.ListAttribute{
+						Description: "IDs of the files made available to the tool, up to 20.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"file_search": schema.SingleNestedAttribute{
+				MarkdownDescription: "Resources of the `file_search` tool.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"vector_store_ids": schema.ListAttribute{
+						Description: "IDs of the vector stores searched by the tool, at most one.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// toolResourcesDataSourceSchema returns the schema of the tool_resources
+// attribute of data sources.
+func toolResourcesDataSourceSchema(description string) dsschema.SingleNestedAttribute {
+	return dsschema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		Attributes: map[string]dsschema.Attribute{
+			"code_interpreter": dsschema.SingleNestedAttribute{
+				MarkdownDescription: "Resources of the `code_interpreter` tool.",
+				Computed:            true,
+				Attributes: map[string]dsschema.Attribute{
+					"file_ids": dsschema.ListAttribute{
+						Description: "IDs of the files made available to the tool.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			"file_search": dsschema.SingleNestedAttribute{
+				MarkdownDescription: "Resources of the `file_search` tool.",
+				Computed:            true,
+				Attributes: map[string]dsschema.Attribute{
+					"vector_store_ids": dsschema.ListAttribute{
+						Description: "IDs of the vector stores searched by the tool.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// toolResourcesValue converts the tool_resources attribute to the object sent
+// to the API. With clear set, unset tools are sent with empty lists, so an
+// update removes the resources previously attached.
+func toolResourcesValue(ctx context.Context, model *toolResourcesModel, clear bool) (*toolResources, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if model == nil && !clear {
+		return nil, diags
+	}
+	if model == nil {
+		model = &toolResourcesModel{}
+	}
+
+	result := &toolResources{}
+
+	if model.CodeInterpreter != nil || clear {
+		result.CodeInterpreter = &struct {
+			FileIDs []string `json:"file_ids"`
+		}{FileIDs: []string{}}
+		if model.CodeInterpreter != nil && !model.CodeInterpreter.FileIDs.IsNull() {
+			diags.Append(model.CodeInterpreter.FileIDs.ElementsAs(ctx, &result.CodeInterpreter.FileIDs, false)...)
+		}
+	}
+
+	if model.FileSearch != nil || clear {
+		result.FileSearch = &struct {
+			VectorStoreIDs []string `json:"vector_store_ids"`
+		}{VectorStoreIDs: []string{}}
+		if model.FileSearch != nil && !model.FileSearch.VectorStoreIDs.IsNull() {
+			diags.Append(model.FileSearch.VectorStoreIDs.ElementsAs(ctx, &result.FileSearch.VectorStoreIDs, false)...)
+		}
+	}
+
+	return result, diags
+}
+
+// toolResourcesFromAPI converts the tool_resources returned by the API to the
+// attribute. Tools without resources are kept null when they were null, so an
+// unset attribute does not show a diff.
+func toolResourcesFromAPI(ctx context.Context, current *toolResourcesModel, resources *toolResources) (*toolResourcesModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	set := current != nil
+	if current == nil {
+		current = &toolResourcesModel{}
+	}
+	result := &toolResourcesModel{}
+
+	if resources != nil && resources.CodeInterpreter != nil && len(resources.CodeInterpreter.FileIDs) > 0 {
+		fileIDs, d := types.ListValueFrom(ctx, types.StringType, resources.CodeInterpreter.FileIDs)
+		diags.Append(d...)
+		result.CodeInterpreter = &codeInterpreterResourcesModel{FileIDs: fileIDs}
+	} else if current.CodeInterpreter != nil {
+		result.CodeInterpreter = &codeInterpreterResourcesModel{FileIDs: emptyList(current.CodeInterpreter.FileIDs)}
+	}
+
+	if resources != nil && resources.FileSearch != nil && len(resources.FileSearch.VectorStoreIDs) > 0 {
+		vectorStoreIDs, d := types.ListValueFrom(ctx, types.StringType, resources.FileSearch.VectorStoreIDs)
+		diags.Append(d...)
+		result.FileSearch = &fileSearchResourcesModel{VectorStoreIDs: vectorStoreIDs}
+	} else if current.FileSearch != nil {
+		result.FileSearch = &fileSearchResourcesModel{VectorStoreIDs: emptyList(current.FileSearch.VectorStoreIDs)}
+	}
+
+	if result.CodeInterpreter == nil && result.FileSearch == nil && !set {
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// emptyList returns an empty list of strings, or the null list as is.
+func emptyList(current types.List) types.List {
+	if current.IsNull() {
+		return current
+	}
+
+	return types.ListValueMust(types.StringType, []attr.Value{})
+}
+
+// toolResourcesDataFromAPI converts the tool_resources returned by the API to
+// the attribute of data sources, with empty lists for the tools without
+// resources.
+func toolResourcesDataFromAPI(ctx context.Context, resources *toolResources) (*toolResourcesModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fileIDs := []string{}
+	vectorStoreIDs := []string{}
+	if resources != nil && resources.CodeInterpreter != nil {
+		fileIDs = append(fileIDs, resources.CodeInterpreter.FileIDs...)
+	}
+	if resources != nil && resources.FileSearch != nil {
+		vectorStoreIDs = append(vectorStoreIDs, resources.FileSearch.VectorStoreIDs...)
+	}
+
+	fileIDsValue, d := types.ListValueFrom(ctx, types.StringType, fileIDs)
+	diags.Append(d...)
+	vectorStoreIDsValue, d := types.ListValueFrom(ctx, types.StringType, vectorStoreIDs)
+	diags.Append(d...)
+
+	return &toolResourcesModel{
+		CodeInterpreter: &codeInterpreterResourcesModel{FileIDs: fileIDsValue},
+		FileSearch:      &fileSearchResourcesModel{VectorStoreIDs: vectorStoreIDsValue},
+	}, diags
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &truncateToTokensFunction{}
+)
+
+// NewTruncateToTokensFunction is a helper function to simplify the provider implementation.
+func NewTruncateToTokensFunction() function.Function {
+	return &truncateToTokensFunction{}
+}
+
+// truncateToTokensFunction is the function implementation.
+type truncateToTokensFunction struct{}
+
+// Metadata returns the function name.
+func (f *truncateToTokensFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "truncate_to_tokens"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *truncateToTokensFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Truncates a text to a number of tokens",
+		MarkdownDescription: "Returns the beginning of a text fitting in `max_tokens` tokens of the model, such as the context retrieved for the instructions of an assistant. A text within the budget is returned unchanged, and a character encoded with several tokens is never split.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "text",
+				Description: "Text to truncate.",
+			},
+			function.StringParameter{
+				Name:                "model",
+				MarkdownDescription: "Model the text is sent to, such as `gpt-4o`. Fine-tuned models use the tokenizer of their base model.",
+			},
+			function.Int64Parameter{
+				Name:        "max_tokens",
+				Description: "Maximum number of tokens of the text, at least 0.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run truncates the text.
+func (f *truncateToTokensFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text, model string
+	var maxTokens int64
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &text, &model, &maxTokens)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if maxTokens < 0 {
+		resp.Diagnostics.AddArgumentError(2, "Invalid max tokens", fmt.Sprintf("max_tokens must be at least 0, got %d.", maxTokens))
+		return
+	}
+
+	tokenizer, err := modelTokenizer(model)
+	if err != nil {
+		resp.Diagnostics.AddArgumentError(1, "Unsupported model", err.Error())
+		return
+	}
+
+	tokens := tokenizer.EncodeOrdinary(text)
+	if len(tokens) <= int(maxTokens) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, text)...)
+		return
+	}
+
+	// The end is moved back to the end of a character.
+	end := int(maxTokens)
+	for end > 0 && !utf8.ValidString(tokenizer.Decode(tokens[:end])) {
+		end--
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, tokenizer.Decode(tokens[:end]))...)
+}
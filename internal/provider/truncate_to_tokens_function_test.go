@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTruncateToTokensFunction(t *testing.T) {
+	tests := map[string]struct {
+		text      string
+		maxTokens int64
+		expected  string
+	}{
+		"truncated": {
+			text:      "one two three four five",
+			maxTokens: 3,
+			expected:  "one two three",
+		},
+		"within budget": {
+			text:      "one two three",
+			maxTokens: 3,
+			expected:  "one two three",
+		},
+		"no tokens": {
+			text:      "one two three",
+			maxTokens: 0,
+			expected:  "",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := runFunction(t, NewTruncateToTokensFunction(), types.StringValue(test.text), types.StringValue("gpt-4o"), types.Int64Value(test.maxTokens))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !result.Equal(types.StringValue(test.expected)) {
+				t.Errorf("expected %q, got %s", test.expected, result)
+			}
+		})
+	}
+
+	// The characters encoded with several tokens are not split.
+	for maxTokens := int64(0); maxTokens < 8; maxTokens++ {
+		result, diags := runFunction(t, NewTruncateToTokensFunction(), types.StringValue("🙂🚀🙂"), types.StringValue("gpt-4"), types.Int64Value(maxTokens))
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if text := result.(types.String).ValueString(); !utf8.ValidString(text) {
+			t.Errorf("expected valid UTF-8 with %d tokens, got %q", maxTokens, text)
+		}
+	}
+
+	if _, diags := runFunction(t, NewTruncateToTokensFunction(), types.StringValue("text"), types.StringValue("gpt-4o"), types.Int64Value(-1)); !diags.HasError() {
+		t.Error("expected an error for a negative max_tokens")
+	}
+}
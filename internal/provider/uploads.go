@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// maxFileUploadBytes is the size of the largest file accepted by the Files
+// API, the larger files are uploaded in parts with the Uploads API.
+const maxFileUploadBytes = 512 << 20
+
+// defaultUploadPartSize and defaultUploadConcurrency are the size of the parts
+// of the files uploaded with the Uploads API and the number of parts uploaded
+// at once, unless set in the provider configuration. The Uploads API accepts
+// parts of at most 64 MB.
+const (
+	defaultUploadPartSize    = 64 << 20
+	defaultUploadConcurrency = 4
+)
+
+// uploadObject is an upload of the Uploads API, File is set once completed.
+type uploadObject struct {
+	ID     string      `json:"id"`
+	Status string      `json:"status"`
+	File   *fileObject `json:"file"`
+}
+
+// uploadFileInParts uploads content with the Uploads API, in parts of
+// uploadPartSize uploaded uploadConcurrency at a time, and returns the file
+// created once the upload is completed. The parts are streamed from content,
+// which is never read in memory as a whole. The upload is cancelled when a part
+// fails.
+func (c *openaiClient) uploadFileInParts(ctx context.Context, filename, purpose string, content *io.SectionReader) (fileObject, error) {
+	mimeType := mime.TypeByExtension(filepath.Ext(filename))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(multipartFile{section: content}.head())
+	}
+
+	// The checksum is computed first, so an unreadable file fails before the
+	// upload is created.
+	checksum := md5.New()
+	if _, err := io.Copy(checksum, io.NewSectionReader(content, 0, content.Size())); err != nil {
+		return fileObject{}, err
+	}
+
+	var upload uploadObject
+	err := c.doJSON(ctx, http.MethodPost, "/uploads", map[string]any{
+		"filename":  filename,
+		"purpose":   purpose,
+		"bytes":     content.Size(),
+		"mime_type": mimeType,
+	}, &upload)
+	if err != nil {
+		return fileObject{}, err
+	}
+
+	partSize := int64(c.uploadPartSize)
+	var parts []*io.SectionReader
+	for offset := int64(0); offset < content.Size(); offset += partSize {
+		parts = append(parts, io.NewSectionReader(content, offset, min(partSize, content.Size()-offset)))
+	}
+
+	partIDs := make([]string, len(parts))
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(c.uploadConcurrency)
+	for i, part := range parts {
+		group.Go(func() error {
+			var uploaded struct {
+				ID string `json:"id"`
+			}
+			err := c.doMultipart(groupCtx, "/uploads/"+upload.ID+"/parts", nil, []multipartFile{
+				{field: "data", name: filename, section: part},
+			}, &uploaded)
+			partIDs[i] = uploaded.ID
+			return err
+		})
+	}
+	if err := group.Wait(); err != nil {
+		// Release the parts already uploaded, ctx may be done after a timeout.
+		_ = c.doJSON(context.WithoutCancel(ctx), http.MethodPost, "/uploads/"+upload.ID+"/cancel", nil, nil)
+		return fileObject{}, err
+	}
+
+	// The checksum lets OpenAI verify the parts were assembled as expected.
+	err = c.doJSON(ctx, http.MethodPost, "/uploads/"+upload.ID+"/complete", map[string]any{
+		"part_ids": partIDs,
+		"md5":      hex.EncodeToString(checksum.Sum(nil)),
+	}, &upload)
+	if err != nil {
+		return fileObject{}, err
+	}
+	if upload.File == nil {
+		return fileObject{}, fmt.Errorf("the upload %s is %s, without a file", upload.ID, upload.Status)
+	}
+
+	return *upload.File, nil
+}
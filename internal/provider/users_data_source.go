@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *openaiClient
+}
+
+// usersDataSourceModel maps the data source schema data.
+type usersDataSourceModel struct {
+	Emails []types.String   `tfsdk:"emails"`
+	Users  []usersItemModel `tfsdk:"users"`
+}
+
+// usersItemModel maps a user of the list.
+type usersItemModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Email   types.String `tfsdk:"email"`
+	Role    types.String `tfsdk:"role"`
+	AddedAt types.Int64  `tfsdk:"added_at"`
+}
+
+// userObject is a user returned by the Administration API.
+type userObject struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Role    string `json:"role"`
+	AddedAt int64  `json:"added_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the users of the OpenAI organization. Requires the `admin_api_key` of the provider.",
+		Attributes: map[string]schema.Attribute{
+			"emails": schema.ListAttribute{
+				Description: "Only list the users with these email addresses, compared case-insensitively. Defaults to all the users of the organization.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "Users of the organization.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the user.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email address of the user.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the user in the organization, `owner` or `reader`.",
+							Computed:            true,
+						},
+						"added_at": schema.Int64Attribute{
+							Description: "Unix timestamp, in seconds, of the addition of the user to the organization.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	d.client.requireAdminAPIKey(&resp.Diagnostics, "openai_users")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data usersDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.listUsers(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to list OpenAI users", "", err)
+		return
+	}
+
+	emails := map[string]bool{}
+	for _, email := range data.Emails {
+		emails[strings.ToLower(email.ValueString())] = true
+	}
+
+	data.Users = []usersItemModel{}
+	for _, user := range users {
+		if data.Emails != nil && !emails[strings.ToLower(user.Email)] {
+			continue
+		}
+
+		data.Users = append(data.Users, usersItemModel{
+			ID:      types.StringValue(user.ID),
+			Name:    types.StringValue(user.Name),
+			Email:   types.StringValue(user.Email),
+			Role:    types.StringValue(user.Role),
+			AddedAt: types.Int64Value(user.AddedAt),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listUsers lists the users of the organization.
+func (c *openaiClient) listUsers(ctx context.Context) ([]userObject, error) {
+	var users []userObject
+
+	query := url.Values{"limit": {"100"}}
+	for {
+		var page struct {
+			Data    []userObject `json:"data"`
+			HasMore bool         `json:"has_more"`
+			LastID  string       `json:"last_id"`
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/organization/users?"+query.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+
+		users = append(users, page.Data...)
+		if !page.HasMore || page.LastID == "" {
+			return users, nil
+		}
+		query.Set("after", page.LastID)
+	}
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &validateFinetuneJSONLFunction{}
+)
+
+// finetuneRoles are the roles of the messages of a chat fine-tuning example.
+var finetuneRoles = []string{"system", "developer", "user", "assistant", "tool", "function"}
+
+// finetuneMinExamples is the minimum number of examples of a fine-tuning
+// file, and finetuneMaxExampleTokens the maximum number of tokens of an
+// example of the gpt-4o and gpt-4.1 models, see
+// https://platform.openai.com/docs/guides/fine-tuning.
+const (
+	finetuneMinExamples      = 10
+	finetuneMaxExampleTokens = 65536
+)
+
+// finetuneMaxErrors is the number of errors reported before the others are
+// summarized, so a file with a wrong format does not report every line.
+const finetuneMaxErrors = 20
+
+// finetuneExample is an example of a chat fine-tuning file.
+type finetuneExample struct {
+	Messages []map[string]json.RawMessage `json:"messages"`
+}
+
+// NewValidateFinetuneJSONLFunction is a helper function to simplify the provider implementation.
+func NewValidateFinetuneJSONLFunction() function.Function {
+	return &validateFinetuneJSONLFunction{}
+}
+
+// validateFinetuneJSONLFunction is the function implementation.
+type validateFinetuneJSONLFunction struct{}
+
+// Metadata returns the function name.
+func (f *validateFinetuneJSONLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_finetune_jsonl"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *validateFinetuneJSONLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validates a chat fine-tuning file",
+		MarkdownDescription: fmt.Sprintf("Validates the examples of a chat fine-tuning file in the JSON Lines format and returns the content unchanged, so the errors are reported by line during plan rather than when the fine-tuning job fails. Each example must have `messages` with a known `role`, a `content` unless the assistant calls a tool, a `weight` of 0 or 1 only on the assistant messages, and at least one assistant message. The file must have at least %d examples, each of at most %d tokens of the `o200k_base` encoding of the `gpt-4o` and `gpt-4.1` models.", finetuneMinExamples, finetuneMaxExampleTokens),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "Content of the fine-tuning file, e.g. read with `file` or encoded with `jsonlencode`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run validates the examples.
+func (f *validateFinetuneJSONLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &content)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errs := validateFinetuneJSONL(content); len(errs) > 0 {
+		if len(errs) > finetuneMaxErrors {
+			errs = append(errs[:finetuneMaxErrors], fmt.Sprintf("and %d more errors", len(errs)-finetuneMaxErrors))
+		}
+		resp.Diagnostics.AddArgumentError(0, "Invalid fine-tuning file", "The fine-tuning file is invalid:\n  - "+strings.Join(errs, "\n  - "))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, content)...)
+}
+
+// validateFinetuneJSONL returns the errors of the examples of a chat
+// fine-tuning file, each prefixed with its line.
+func validateFinetuneJSONL(content string) []string {
+	var errs []string
+	examples := 0
+
+	for i, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		examples++
+
+		for _, err := range validateFinetuneExample(line) {
+			errs = append(errs, fmt.Sprintf("line %d: %s", i+1, err))
+		}
+	}
+
+	if examples < finetuneMinExamples {
+		errs = append(errs, fmt.Sprintf("the file must have at least %d examples, got %d", finetuneMinExamples, examples))
+	}
+
+	return errs
+}
+
+// validateFinetuneExample returns the errors of an example of a chat
+// fine-tuning file.
+func validateFinetuneExample(line string) []string {
+	var example finetuneExample
+	if err := json.Unmarshal([]byte(line), &example); err != nil {
+		return []string{"the example is not a valid JSON object with messages: " + err.Error()}
+	}
+	if len(example.Messages) == 0 {
+		return []string{"the example has no messages"}
+	}
+
+	var errs []string
+	assistant := false
+	var messages []chatCompletionMessageModel
+
+	for i, message := range example.Messages {
+		var role string
+		if err := json.Unmarshal(message["role"], &role); err != nil || !slices.Contains(finetuneRoles, role) {
+			errs = append(errs, fmt.Sprintf("message %d: role must be one of %s", i, strings.Join(finetuneRoles, ", ")))
+		}
+		assistant = assistant || role == "assistant"
+
+		_, toolCalls := message["tool_calls"]
+		_, functionCall := message["function_call"]
+		rawContent := message["content"]
+		hasContent := len(rawContent) > 0 && string(rawContent) != "null"
+		var text string
+		switch {
+		case hasContent && json.Unmarshal(rawContent, &text) == nil:
+		case hasContent && rawContent[0] == '[':
+			// Content parts, such as the images of vision fine-tuning.
+			text = string(rawContent)
+		case role == "assistant" && (toolCalls || functionCall):
+		default:
+			errs = append(errs, fmt.Sprintf("message %d: content must be a string", i))
+		}
+
+		if rawWeight, ok := message["weight"]; ok {
+			var weight int
+			if role != "assistant" {
+				errs = append(errs, fmt.Sprintf("message %d: weight is only supported on the assistant messages", i))
+			} else if err := json.Unmarshal(rawWeight, &weight); err != nil || (weight != 0 && weight != 1) {
+				errs = append(errs, fmt.Sprintf("message %d: weight must be 0 or 1", i))
+			}
+		}
+
+		messages = append(messages, chatMessage(role, text))
+	}
+
+	if !assistant {
+		errs = append(errs, "the example has no assistant message to learn from")
+	}
+	tokens, err := countChatTokens("gpt-4o", messages)
+	if err != nil {
+		return append(errs, err.Error())
+	}
+	if tokens > finetuneMaxExampleTokens {
+		errs = append(errs, fmt.Sprintf("the example has %d tokens, at most %d are supported", tokens, finetuneMaxExampleTokens))
+	}
+
+	return errs
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateFinetuneJSONLFunction(t *testing.T) {
+	example := `{"messages": [{"role": "system", "content": "You are terse."}, {"role": "user", "content": "Hello"}, {"role": "assistant", "content": "Hi", "weight": 1}]}`
+	examples := func(lines ...string) string {
+		for len(lines) < finetuneMinExamples {
+			lines = append(lines, example)
+		}
+		return strings.Join(lines, "\n") + "\n"
+	}
+
+	tests := map[string]struct {
+		content  string
+		expected []string
+	}{
+		"valid": {
+			content: examples(),
+		},
+		"tool calls": {
+			content: examples(`{"messages": [{"role": "user", "content": "Weather?"}, {"role": "assistant", "tool_calls": [{"id": "call_1", "type": "function", "function": {"name": "weather", "arguments": "{}"}}]}, {"role": "tool", "tool_call_id": "call_1", "content": "Sunny"}, {"role": "assistant", "content": "Sunny"}]}`),
+		},
+		"too few examples": {
+			content:  example + "\n",
+			expected: []string{"at least 10 examples, got 1"},
+		},
+		"invalid JSON": {
+			content:  examples(`{"messages": [`),
+			expected: []string{"line 1: the example is not a valid JSON object"},
+		},
+		"invalid messages": {
+			content: examples(example, `{"messages": [{"role": "bot", "content": "Hello"}, {"role": "user", "content": null, "weight": 1}, {"role": "assistant", "content": "Hi", "weight": 2}]}`),
+			expected: []string{
+				"line 2: message 0: role must be one of",
+				"line 2: message 1: content must be a string",
+				"line 2: message 1: weight is only supported on the assistant messages",
+				"line 2: message 2: weight must be 0 or 1",
+			},
+		},
+		"no assistant message": {
+			content:  examples(`{"messages": [{"role": "user", "content": "Hello"}]}`),
+			expected: []string{"line 1: the example has no assistant message"},
+		},
+		"too many tokens": {
+			content:  examples(`{"messages": [{"role": "user", "content": "` + strings.Repeat("token ", finetuneMaxExampleTokens) + `"}, {"role": "assistant", "content": "Hi"}]}`),
+			expected: []string{"line 1: the example has", "tokens, at most 65536 are supported"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := runFunction(t, NewValidateFinetuneJSONLFunction(), types.StringValue(test.content))
+
+			if len(test.expected) == 0 {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				if !result.Equal(types.StringValue(test.content)) {
+					t.Errorf("expected the content to be returned unchanged, got %s", result)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatal("expected an error")
+			}
+			for _, expected := range test.expected {
+				if !strings.Contains(diags[0].Detail(), expected) {
+					t.Errorf("expected the error to contain %q, got %q", expected, diags[0].Detail())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &validateJSONSchemaFunction{}
+)
+
+// jsonSchemaTypes are the types of the JSON Schema type keyword.
+var jsonSchemaTypes = []string{"array", "boolean", "integer", "null", "number", "object", "string"}
+
+// strictSchemaUnsupportedKeywords are the keywords rejected by the structured
+// outputs of OpenAI, see
+// https://platform.openai.com/docs/guides/structured-outputs#supported-schemas.
+var strictSchemaUnsupportedKeywords = []string{"allOf", "dependentRequired", "dependentSchemas", "else", "if", "not", "patternProperties", "then"}
+
+// strictSchemaMaxDepth and strictSchemaMaxProperties are the maximum nesting
+// depth of the objects and the maximum number of properties of a schema of the
+// structured outputs of OpenAI.
+const (
+	strictSchemaMaxDepth      = 10
+	strictSchemaMaxProperties = 5000
+)
+
+// NewValidateJSONSchemaFunction is a helper function to simplify the provider implementation.
+func NewValidateJSONSchemaFunction() function.Function {
+	return &validateJSONSchemaFunction{}
+}
+
+// validateJSONSchemaFunction is the function implementation.
+type validateJSONSchemaFunction struct{}
+
+// Metadata returns the function name.
+func (f *validateJSONSchemaFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_json_schema"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *validateJSONSchemaFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validates a JSON schema",
+		MarkdownDescription: "Validates a JSON Schema document and returns it unchanged, so the errors are reported during plan rather than rejected by OpenAI during apply. With `strict`, the schema is also checked against the restrictions of the [structured outputs](https://platform.openai.com/docs/guides/structured-outputs#supported-schemas) of OpenAI: the root is an object, every object sets `additionalProperties` to `false` and requires all its properties, and keywords such as `allOf` or `not` are not used.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schema",
+				MarkdownDescription: "JSON Schema document, e.g. encoded with `jsonencode`.",
+			},
+			function.BoolParameter{
+				Name:        "strict",
+				Description: "Whether to check the restrictions of the structured outputs of OpenAI.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run validates the schema.
+func (f *validateJSONSchemaFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schema string
+	var strict bool
+	resp.Diagnostics.Append(req.Arguments.Get(ctx, &schema, &strict)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if errs := validateJSONSchema(schema, strict); len(errs) > 0 {
+		resp.Diagnostics.AddArgumentError(0, "Invalid JSON schema", "The JSON schema is invalid:\n  - "+strings.Join(errs, "\n  - "))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, schema)...)
+}
+
+// validateJSONSchema returns the errors of the JSON schema document, each
+// prefixed with the JSON pointer of the invalid value.
+func validateJSONSchema(document string, strict bool) []string {
+	var root any
+	if err := json.Unmarshal([]byte(document), &root); err != nil {
+		return []string{"the schema is not valid JSON: " + err.Error()}
+	}
+
+	v := &jsonSchemaValidator{root: root, strict: strict}
+	if schema, ok := root.(map[string]any); strict && ok && schema["type"] != "object" {
+		v.addError("", "the root of a strict schema must be of type object")
+	}
+	v.validate("", root, 0)
+
+	if strict && v.properties > strictSchemaMaxProperties {
+		v.addError("", fmt.Sprintf("a strict schema has at most %d properties, got %d", strictSchemaMaxProperties, v.properties))
+	}
+
+	return v.errors
+}
+
+// jsonSchemaValidator walks a JSON schema, collecting its errors.
+type jsonSchemaValidator struct {
+	root       any
+	strict     bool
+	properties int
+	errors     []string
+}
+
+// addError adds an error at the JSON pointer.
+func (v *jsonSchemaValidator) addError(pointer, message string) {
+	if pointer == "" {
+		pointer = "/"
+	}
+	v.errors = append(v.errors, pointer+": "+message)
+}
+
+// validate validates the schema at the JSON pointer, nested in depth objects.
+func (v *jsonSchemaValidator) validate(pointer string, value any, depth int) {
+	// true and false are valid schemas, matching any or no value.
+	if _, ok := value.(bool); ok {
+		return
+	}
+
+	schema, ok := value.(map[string]any)
+	if !ok {
+		v.addError(pointer, "a schema must be an object or a boolean")
+		return
+	}
+
+	v.validateType(pointer, schema)
+
+	if v.strict {
+		for _, keyword := range strictSchemaUnsupportedKeywords {
+			if _, ok := schema[keyword]; ok {
+				v.addError(pointer+"/"+keyword, "the keyword is not supported by the structured outputs of OpenAI")
+			}
+		}
+	}
+
+	if ref, ok := schema["$ref"]; ok {
+		v.validateRef(pointer+"/$ref", ref)
+	}
+
+	for _, keyword := range []string{"$defs", "definitions"} {
+		v.validateSchemaMap(pointer+"/"+keyword, schema[keyword], depth)
+	}
+	for _, keyword := range []string{"anyOf", "allOf", "oneOf"} {
+		v.validateSchemaList(pointer+"/"+keyword, schema[keyword], depth)
+	}
+	for _, keyword := range []string{"not", "if", "then", "else", "items", "contains"} {
+		if nested, ok := schema[keyword]; ok {
+			v.validate(pointer+"/"+keyword, nested, depth)
+		}
+	}
+
+	if enum, ok := schema["enum"]; ok {
+		if values, ok := enum.([]any); !ok || len(values) == 0 {
+			v.addError(pointer+"/enum", "enum must be a non-empty array")
+		}
+	}
+
+	v.validateObject(pointer, schema, depth)
+}
+
+// validateType validates the type keyword of the schema.
+func (v *jsonSchemaValidator) validateType(pointer string, schema map[string]any) {
+	value, ok := schema["type"]
+	if !ok {
+		return
+	}
+
+	types, ok := value.([]any)
+	if !ok {
+		types = []any{value}
+	}
+	for _, t := range types {
+		name, ok := t.(string)
+		if !ok || !slices.Contains(jsonSchemaTypes, name) {
+			v.addError(pointer+"/type", fmt.Sprintf("unknown type %v, expected one of %s", t, strings.Join(jsonSchemaTypes, ", ")))
+		}
+	}
+}
+
+// validateObject validates the properties of an object schema, and checks
+// the restrictions of the structured outputs on the objects.
+func (v *jsonSchemaValidator) validateObject(pointer string, schema map[string]any, depth int) {
+	properties := map[string]any{}
+	if value, ok := schema["properties"]; ok {
+		properties, ok = value.(map[string]any)
+		if !ok {
+			v.addError(pointer+"/properties", "properties must be an object")
+		}
+	}
+
+	isObject := schema["type"] == "object" || len(properties) > 0
+	if isObject {
+		depth++
+		if v.strict && depth > strictSchemaMaxDepth {
+			v.addError(pointer, fmt.Sprintf("a strict schema nests at most %d levels of objects", strictSchemaMaxDepth))
+		}
+	}
+
+	v.properties += len(properties)
+	for _, name := range sortedKeys(properties) {
+		v.validate(pointer+"/properties/"+jsonPointerEscape(name), properties[name], depth)
+	}
+
+	var required []string
+	if value, ok := schema["required"]; ok {
+		names, ok := value.([]any)
+		if !ok {
+			v.addError(pointer+"/required", "required must be an array of property names")
+		}
+		for _, n := range names {
+			name, ok := n.(string)
+			if !ok {
+				v.addError(pointer+"/required", fmt.Sprintf("required must be an array of property names, got %v", n))
+				continue
+			}
+			if _, ok := properties[name]; !ok && len(properties) > 0 {
+				v.addError(pointer+"/required", fmt.Sprintf("the required property %q is not defined in properties", name))
+			}
+			required = append(required, name)
+		}
+	}
+
+	additional, hasAdditional := schema["additionalProperties"]
+	if _, ok := additional.(bool); hasAdditional && !ok {
+		v.validate(pointer+"/additionalProperties", additional, depth)
+	}
+
+	if !v.strict || !isObject {
+		return
+	}
+
+	if additional != false {
+		v.addError(pointer, "additionalProperties must be set to false in a strict schema")
+	}
+	for _, name := range sortedKeys(properties) {
+		if !slices.Contains(required, name) {
+			v.addError(pointer+"/required", fmt.Sprintf("the property %q must be required in a strict schema, make it nullable with a type such as [\"string\", \"null\"] instead", name))
+		}
+	}
+}
+
+// validateRef checks the reference points to a schema of the document.
+func (v *jsonSchemaValidator) validateRef(pointer string, value any) {
+	ref, ok := value.(string)
+	if !ok {
+		v.addError(pointer, "$ref must be a string")
+		return
+	}
+	if ref == "#" {
+		return
+	}
+
+	target, ok := strings.CutPrefix(ref, "#/")
+	if !ok {
+		v.addError(pointer, fmt.Sprintf("only the references to the schema itself, such as #/$defs/name, are supported, got %q", ref))
+		return
+	}
+
+	node := v.root
+	for _, token := range strings.Split(target, "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		object, ok := node.(map[string]any)
+		if !ok {
+			node = nil
+			break
+		}
+		node = object[token]
+	}
+	if node == nil {
+		v.addError(pointer, fmt.Sprintf("the reference %q does not resolve to a schema", ref))
+	}
+}
+
+// validateSchemaMap validates the schemas of an object, such as $defs.
+func (v *jsonSchemaValidator) validateSchemaMap(pointer string, value any, depth int) {
+	if value == nil {
+		return
+	}
+
+	schemas, ok := value.(map[string]any)
+	if !ok {
+		v.addError(pointer, "must be an object of schemas")
+		return
+	}
+	for _, name := range sortedKeys(schemas) {
+		v.validate(pointer+"/"+jsonPointerEscape(name), schemas[name], depth)
+	}
+}
+
+// validateSchemaList validates the schemas of an array, such as anyOf.
+func (v *jsonSchemaValidator) validateSchemaList(pointer string, value any, depth int) {
+	if value == nil {
+		return
+	}
+
+	schemas, ok := value.([]any)
+	if !ok || len(schemas) == 0 {
+		v.addError(pointer, "must be a non-empty array of schemas")
+		return
+	}
+	for i, schema := range schemas {
+		v.validate(fmt.Sprintf("%s/%d", pointer, i), schema, depth)
+	}
+}
+
+// jsonPointerEscape escapes a property name to use it in a JSON pointer.
+func jsonPointerEscape(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
+
+// sortedKeys returns the keys of m in order, so the errors are reported in a
+// stable order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
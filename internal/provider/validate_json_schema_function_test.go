@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateJSONSchemaFunction(t *testing.T) {
+	strictSchema := `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"age": {"type": ["integer", "null"]},
+			"address": {"$ref": "#/$defs/address"}
+		},
+		"required": ["name", "age", "address"],
+		"additionalProperties": false,
+		"$defs": {
+			"address": {
+				"type": "object",
+				"properties": {"city": {"type": "string"}},
+				"required": ["city"],
+				"additionalProperties": false
+			}
+		}
+	}`
+
+	tests := map[string]struct {
+		schema   string
+		strict   bool
+		expected []string
+	}{
+		"valid": {
+			schema: `{"type": "object", "properties": {"name": {"type": "string"}}}`,
+		},
+		"strict": {
+			schema: strictSchema,
+			strict: true,
+		},
+		"invalid JSON": {
+			schema:   `{"type": "object"`,
+			expected: []string{"the schema is not valid JSON"},
+		},
+		"unknown type": {
+			schema:   `{"type": "object", "properties": {"name": {"type": "text"}}}`,
+			expected: []string{"/properties/name/type: unknown type text"},
+		},
+		"undefined required property": {
+			schema:   `{"type": "object", "properties": {"name": {"type": "string"}}, "required": ["nmae"]}`,
+			expected: []string{`/required: the required property "nmae" is not defined`},
+		},
+		"unresolved reference": {
+			schema:   `{"type": "object", "properties": {"address": {"$ref": "#/$defs/adress"}}}`,
+			expected: []string{`/properties/address/$ref: the reference "#/$defs/adress" does not resolve`},
+		},
+		"strict root": {
+			schema:   `{"type": "array", "items": {"type": "string"}}`,
+			strict:   true,
+			expected: []string{"/: the root of a strict schema must be of type object"},
+		},
+		"strict optional property": {
+			schema: `{"type": "object", "properties": {"name": {"type": "string"}, "nickname": {"type": "string"}}, "required": ["name"]}`,
+			strict: true,
+			expected: []string{
+				"/: additionalProperties must be set to false",
+				`/required: the property "nickname" must be required`,
+			},
+		},
+		"strict unsupported keyword": {
+			schema:   `{"type": "object", "properties": {"name": {"allOf": [{"type": "string"}]}}, "required": ["name"], "additionalProperties": false}`,
+			strict:   true,
+			expected: []string{"/properties/name/allOf: the keyword is not supported"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := runFunction(t, NewValidateJSONSchemaFunction(), types.StringValue(test.schema), types.BoolValue(test.strict))
+
+			if len(test.expected) == 0 {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				if !result.Equal(types.StringValue(test.schema)) {
+					t.Errorf("expected the schema to be returned unchanged, got %s", result)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatal("expected an error")
+			}
+			detail := diags[0].Detail()
+			for _, expected := range test.expected {
+				if !strings.Contains(detail, expected) {
+					t.Errorf("expected the error to contain %q, got %q", expected, detail)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,595 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &vectorStoreResource{}
+	_ resource.ResourceWithConfigure   = &vectorStoreResource{}
+	_ resource.ResourceWithImportState = &vectorStoreResource{}
+)
+
+// vectorStorePollInterval is the interval between two checks of the status of
+// a vector store while its files are processed.
+const vectorStorePollInterval = 2 * time.Second
+
+// NewVectorStoreResource is a helper function to simplify the provider implementation.
+func NewVectorStoreResource() resource.Resource {
+	return &vectorStoreResource{}
+}
+
+// vectorStoreResource is the resource implementation.
+type vectorStoreResource struct {
+	client *openaiClient
+}
+
+// vectorStoreResourceModel maps the resource schema data.
+type vectorStoreResourceModel struct {
+	ID           types.String                  `tfsdk:"id"`
+	Name         types.String                  `tfsdk:"name"`
+	FileIDs      types.List                    `tfsdk:"file_ids"`
+	ExpiresAfter *vectorStoreExpiresAfterModel `tfsdk:"expires_after"`
+	Metadata     types.Map                     `tfsdk:"metadata"`
+	Status       types.String                  `tfsdk:"status"`
+	UsageBytes   types.Int64                   `tfsdk:"usage_bytes"`
+	FileCounts   types.Object                  `tfsdk:"file_counts"`
+	CreatedAt    types.Int64                   `tfsdk:"created_at"`
+	Project      types.String                  `tfsdk:"project"`
+	Timeouts     timeouts.Value                `tfsdk:"timeouts"`
+}
+
+// vectorStoreExpiresAfterModel maps the expiration policy of a vector store.
+type vectorStoreExpiresAfterModel struct {
+	Days types.Int64 `tfsdk:"days"`
+}
+
+// vectorStoreFileCountsType is the type of the file_counts attribute.
+var vectorStoreFileCountsType = map[string]attr.Type{
+	"in_progress": types.Int64Type,
+	"completed":   types.Int64Type,
+	"failed":      types.Int64Type,
+	"cancelled":   types.Int64Type,
+	"total":       types.Int64Type,
+}
+
+// vectorStoreRequest is the body of a request creating or updating a vector
+// store. The files are only sent on creation, they are added and removed one
+// by one on update.
+type vectorStoreRequest struct {
+	Name         *string                  `json:"name,omitempty"`
+	FileIDs      []string                 `json:"file_ids,omitempty"`
+	ExpiresAfter *vectorStoreExpiresAfter `json:"expires_after"`
+	Metadata     map[string]string        `json:"metadata"`
+}
+
+// vectorStoreExpiresAfter is the expiration policy of a vector store. Vector
+// stores can only expire after a period of inactivity.
+type vectorStoreExpiresAfter struct {
+	Anchor string `json:"anchor"`
+	Days   int64  `json:"days"`
+}
+
+// vectorStoreObject is a vector store returned by the Vector Stores API.
+type vectorStoreObject struct {
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	Status       string                   `json:"status"`
+	UsageBytes   int64                    `json:"usage_bytes"`
+	CreatedAt    int64                    `json:"created_at"`
+	ExpiresAfter *vectorStoreExpiresAfter `json:"expires_after"`
+	Metadata     map[string]string        `json:"metadata"`
+	FileCounts   struct {
+		InProgress int64 `json:"in_progress"`
+		Completed  int64 `json:"completed"`
+		Failed     int64 `json:"failed"`
+		Cancelled  int64 `json:"cancelled"`
+		Total      int64 `json:"total"`
+	} `json:"file_counts"`
+}
+
+// Metadata returns the resource type name.
+func (r *vectorStoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vector_store"
+}
+
+// Schema defines the schema for the resource.
+func (r *vectorStoreResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides an OpenAI vector store, the storage searched by the `file_search` tool of assistants and responses. The files are processed before the creation or update completes, so the store is searchable once applied.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the vector store.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the vector store.",
+				Optional:    true,
+			},
+			"file_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the files of the vector store, e.g. uploaded with `openai_file` and the `assistants` purpose. The files are added to and removed from the store in place.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"expires_after": schema.SingleNestedAttribute{
+				Description: "Expiration policy of the vector store. The vector store never expires by default.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"days": schema.Int64Attribute{
+						Description: "Number of days, between 1 and 365, of inactivity after which the vector store expires.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 365),
+						},
+					},
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Key-value pairs attached to the vector store.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  metadataValidators(),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the vector store, such as `completed` or `expired`.",
+				Computed:            true,
+			},
+			"usage_bytes": schema.Int64Attribute{
+				Description: "Storage used by the files of the vector store, in bytes.",
+				Computed:    true,
+			},
+			"file_counts": schema.SingleNestedAttribute{
+				Description: "Number of files of the vector store, per processing status.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"in_progress": schema.Int64Attribute{
+						Description: "Number of files being processed.",
+						Computed:    true,
+					},
+					"completed": schema.Int64Attribute{
+						Description: "Number of files processed and searchable.",
+						Computed:    true,
+					},
+					"failed": schema.Int64Attribute{
+						Description: "Number of files that could not be processed.",
+						Computed:    true,
+					},
+					"cancelled": schema.Int64Attribute{
+						Description: "Number of files whose processing was cancelled.",
+						Computed:    true,
+					},
+					"total": schema.Int64Attribute{
+						Description: "Number of files.",
+						Computed:    true,
+					},
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp, in seconds, of the creation of the vector store.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project of the vector store, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *vectorStoreResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create a new resource.
+func (r *vectorStoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan vectorStoreResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	storeRequest, diags := plan.request(ctx, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storeRequest.FileIDs, diags = stringListValue(ctx, plan.FileIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var store vectorStoreObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/vector_stores", storeRequest, &store)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating vector store", "Could not create vector store, unexpected error: ", err)
+		return
+	}
+
+	// Keep track of the vector store in state even when its files fail to be
+	// processed, it is deleted with the resource.
+	store, err = r.client.waitVectorStore(ctx, store)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error processing vector store files", "Could not process the files of vector store "+store.ID+": ", err)
+	}
+	warnFailedVectorStoreFiles(&resp.Diagnostics, store)
+
+	// Map response body to schema and populate Computed attribute values
+	diags = plan.refresh(store)
+	resp.Diagnostics.Append(diags...)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *vectorStoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state vectorStoreResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Get refreshed vector store value from OpenAI
+	var store vectorStoreObject
+	err := r.client.doJSON(ctx, http.MethodGet, "/vector_stores/"+state.ID.ValueString(), nil, &store)
+	if removeNotFound(ctx, resp, err, "OpenAI vector store "+state.ID.ValueString()) {
+		return
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI vector store", "Could not read OpenAI vector store ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	fileIDs, err := r.client.listVectorStoreFiles(ctx, store.ID)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Reading OpenAI vector store", "Could not list the files of OpenAI vector store ID "+state.ID.ValueString()+": ", err)
+		return
+	}
+
+	// An empty name is kept null when the attribute was null, and the
+	// expiration policy is only kept when it was configured.
+	if store.Name != "" || !state.Name.IsNull() {
+		state.Name = types.StringValue(store.Name)
+	}
+	if store.ExpiresAfter != nil && state.ExpiresAfter != nil {
+		state.ExpiresAfter.Days = types.Int64Value(store.ExpiresAfter.Days)
+	}
+
+	state.FileIDs, diags = stringListFromAPI(ctx, state.FileIDs, fileIDs)
+	resp.Diagnostics.Append(diags...)
+	state.Metadata, diags = stringMapFromAPI(ctx, state.Metadata, store.Metadata)
+	resp.Diagnostics.Append(diags...)
+	diags = state.refresh(store)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *vectorStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state vectorStoreResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	storeRequest, diags := plan.request(ctx, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storePath := "/vector_stores/" + plan.ID.ValueString()
+
+	var store vectorStoreObject
+	err := r.client.doJSON(ctx, http.MethodPost, storePath, storeRequest, &store)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating OpenAI vector store", "Could not update vector store, unexpected error: ", err)
+		return
+	}
+
+	// Add and remove the files changed since the last apply, the files kept
+	// are not processed again.
+	planned, diags := stringListValue(ctx, plan.FileIDs)
+	resp.Diagnostics.Append(diags...)
+	current, diags := stringListValue(ctx, state.FileIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, removed := diffStrings(current, planned)
+	for _, fileID := range removed {
+		err := r.client.doJSON(ctx, http.MethodDelete, storePath+"/files/"+fileID, nil, nil)
+		if err != nil && errorStatusCode(err) != http.StatusNotFound {
+			addAPIError(&resp.Diagnostics, "Error Updating OpenAI vector store", "Could not remove file "+fileID+" from vector store, unexpected error: ", err)
+			return
+		}
+	}
+	for _, fileID := range added {
+		err := r.client.doJSON(ctx, http.MethodPost, storePath+"/files", map[string]string{"file_id": fileID}, nil)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error Updating OpenAI vector store", "Could not add file "+fileID+" to vector store, unexpected error: ", err)
+			return
+		}
+	}
+
+	if len(added) > 0 || len(removed) > 0 {
+		err = r.client.doJSON(withFreshReads(ctx), http.MethodGet, storePath, nil, &store)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error Reading OpenAI vector store", "Could not read OpenAI vector store ID "+plan.ID.ValueString()+": ", err)
+			return
+		}
+	}
+
+	store, err = r.client.waitVectorStore(ctx, store)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error processing vector store files", "Could not process the files of vector store "+store.ID+": ", err)
+	}
+	warnFailedVectorStoreFiles(&resp.Diagnostics, store)
+
+	diags = plan.refresh(store)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *vectorStoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state vectorStoreResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing vector store, its files are kept
+	err := r.client.doJSON(ctx, http.MethodDelete, "/vector_stores/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI vector store", "Could not delete vector store, unexpected error: ", err)
+		return
+	}
+}
+
+func (r *vectorStoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept the import IDs of the other OpenAI providers, see
+	// vectorStoreIDPattern.
+	vectorStoreID := vectorStoreIDPattern.FindString(req.ID)
+	if vectorStoreID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: vector_store_id, e.g. vs_abc123. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vectorStoreID)...)
+}
+
+// request returns the body of a request creating or updating the vector store,
+// without its files. With clear set, a name removed from the configuration is
+// cleared, it would otherwise keep its previous value.
+func (m *vectorStoreResourceModel) request(ctx context.Context, clear bool) (vectorStoreRequest, diag.Diagnostics) {
+	storeRequest := vectorStoreRequest{
+		Name: m.Name.ValueStringPointer(),
+	}
+
+	noName := ""
+	if clear && storeRequest.Name == nil {
+		storeRequest.Name = &noName
+	}
+
+	if m.ExpiresAfter != nil {
+		storeRequest.ExpiresAfter = &vectorStoreExpiresAfter{
+			Anchor: "last_active_at",
+			Days:   m.ExpiresAfter.Days.ValueInt64(),
+		}
+	}
+
+	var diags diag.Diagnostics
+	storeRequest.Metadata, diags = stringMapValue(ctx, m.Metadata)
+
+	return storeRequest, diags
+}
+
+// refresh populates the computed attributes from the vector store returned by
+// the API.
+func (m *vectorStoreResourceModel) refresh(store vectorStoreObject) diag.Diagnostics {
+	m.ID = types.StringValue(store.ID)
+	m.Status = types.StringValue(store.Status)
+	m.UsageBytes = types.Int64Value(store.UsageBytes)
+	m.CreatedAt = types.Int64Value(store.CreatedAt)
+
+	var diags diag.Diagnostics
+	m.FileCounts, diags = store.fileCounts()
+
+	return diags
+}
+
+// fileCounts returns the file_counts attribute of the vector store.
+func (s vectorStoreObject) fileCounts() (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(vectorStoreFileCountsType, map[string]attr.Value{
+		"in_progress": types.Int64Value(s.FileCounts.InProgress),
+		"completed":   types.Int64Value(s.FileCounts.Completed),
+		"failed":      types.Int64Value(s.FileCounts.Failed),
+		"cancelled":   types.Int64Value(s.FileCounts.Cancelled),
+		"total":       types.Int64Value(s.FileCounts.Total),
+	})
+}
+
+// waitVectorStore polls the vector store until its files are processed.
+func (c *openaiClient) waitVectorStore(ctx context.Context, store vectorStoreObject) (vectorStoreObject, error) {
+	ctx = withFreshReads(ctx)
+
+	for store.Status == "in_progress" {
+		select {
+		case <-ctx.Done():
+			return store, ctx.Err()
+		case <-time.After(vectorStorePollInterval):
+		}
+
+		// The first polls can hit a replica not aware of the new store yet.
+		err := retryNotFound(ctx, func(ctx context.Context) error {
+			return c.doJSON(ctx, http.MethodGet, "/vector_stores/"+store.ID, nil, &store)
+		})
+		if err != nil {
+			return store, err
+		}
+	}
+
+	return store, nil
+}
+
+// listVectorStoreFiles returns the IDs of the files of the vector store.
+func (c *openaiClient) listVectorStoreFiles(ctx context.Context, vectorStoreID string) ([]string, error) {
+	var fileIDs []string
+
+	query := url.Values{"limit": {"100"}}
+	for {
+		var page struct {
+			Data []struct {
+				ID string `json:"id"`
+			} `json:"data"`
+			HasMore bool   `json:"has_more"`
+			LastID  string `json:"last_id"`
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/vector_stores/"+vectorStoreID+"/files?"+query.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+
+		for _, file := range page.Data {
+			fileIDs = append(fileIDs, file.ID)
+		}
+		if !page.HasMore || page.LastID == "" {
+			return fileIDs, nil
+		}
+		query.Set("after", page.LastID)
+	}
+}
+
+// warnFailedVectorStoreFiles adds a warning when files of the vector store
+// could not be processed, they are not searchable.
+func warnFailedVectorStoreFiles(diags *diag.Diagnostics, store vectorStoreObject) {
+	if store.FileCounts.Failed == 0 {
+		return
+	}
+
+	diags.AddWarning(
+		"Vector store files not processed",
+		fmt.Sprintf("%d of the %d files of the vector store %s could not be processed and are not searchable. "+
+			"Check the format of the files, see https://platform.openai.com/docs/assistants/tools/file-search#supported-files",
+			store.FileCounts.Failed, store.FileCounts.Total, store.ID),
+	)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVectorStoreResource(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed("openai_vector_store", "/vector_stores/"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVectorStoreResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openai_vector_store.test", "id"),
+					resource.TestCheckResourceAttr("openai_vector_store.test", "name", testAccNamePrefix+"vector-store"),
+					resource.TestCheckResourceAttr("openai_vector_store.test", "status", "completed"),
+					resource.TestCheckResourceAttr("openai_vector_store.test", "file_counts.total", "0"),
+				),
+			},
+			{
+				ResourceName:      "openai_vector_store.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The file is added to the vector store in place.
+				Config: testAccVectorStoreResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("openai_vector_store.test", "file_ids.0", "openai_file.test", "id"),
+					resource.TestCheckResourceAttr("openai_vector_store.test", "file_counts.completed", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVectorStoreResourceConfig(withFile bool) string {
+	fileIDs := ""
+	if withFile {
+		fileIDs = "file_ids = [openai_file.test.id]"
+	}
+
+	return fmt.Sprintf(`
+resource "openai_file" "test" {
+  content  = "The provider is tested against a mock of the OpenAI API."
+  filename = %q
+  purpose  = "assistants"
+}
+
+resource "openai_vector_store" "test" {
+  name = %q
+  %s
+}
+`, testAccNamePrefix+"file.txt", testAccNamePrefix+"vector-store", fileIDs)
+}
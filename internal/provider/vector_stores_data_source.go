@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vectorStoresDataSource{}
+	_ datasource.DataSourceWithConfigure = &vectorStoresDataSource{}
+)
+
+// NewVectorStoresDataSource is a helper function to simplify the provider implementation.
+func NewVectorStoresDataSource() datasource.DataSource {
+	return &vectorStoresDataSource{}
+}
+
+// vectorStoresDataSource is the data source implementation.
+type vectorStoresDataSource struct {
+	client *openaiClient
+}
+
+// vectorStoresDataSourceModel maps the data source schema data.
+type vectorStoresDataSourceModel struct {
+	VectorStores []vectorStoresItemModel `tfsdk:"vector_stores"`
+}
+
+// vectorStoresItemModel maps a vector store of the list.
+type vectorStoresItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Status     types.String `tfsdk:"status"`
+	UsageBytes types.Int64  `tfsdk:"usage_bytes"`
+	FileCounts types.Object `tfsdk:"file_counts"`
+	Metadata   types.Map    `tfsdk:"metadata"`
+	CreatedAt  types.Int64  `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *vectorStoresDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vector_stores"
+}
+
+// Schema defines the schema for the data source.
+func (d *vectorStoresDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the OpenAI vector stores of the project.",
+		Attributes: map[string]schema.Attribute{
+			"vector_stores": schema.ListNestedAttribute{
+				Description: "Vector stores of the project, from the most recent.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the vector store.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the vector store.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the vector store, such as `in_progress`, `completed` or `expired`.",
+							Computed:            true,
+						},
+						"usage_bytes": schema.Int64Attribute{
+							Description: "Storage used by the files of the vector store, in bytes.",
+							Computed:    true,
+						},
+						"file_counts": schema.SingleNestedAttribute{
+							Description: "Number of files of the vector store, per processing status.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"in_progress": schema.Int64Attribute{
+									Description: "Number of files being processed.",
+									Computed:    true,
+								},
+								"completed": schema.Int64Attribute{
+									Description: "Number of files processed and searchable.",
+									Computed:    true,
+								},
+								"failed": schema.Int64Attribute{
+									Description: "Number of files that could not be processed.",
+									Computed:    true,
+								},
+								"cancelled": schema.Int64Attribute{
+									Description: "Number of files whose processing was cancelled.",
+									Computed:    true,
+								},
+								"total": schema.Int64Attribute{
+									Description: "Number of files.",
+									Computed:    true,
+								},
+							},
+						},
+						"metadata": schema.MapAttribute{
+							Description: "Key-value pairs attached to the vector store.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Unix timestamp, in seconds, of the creation of the vector store.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *vectorStoresDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *vectorStoresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vectorStoresDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stores, err := d.client.listVectorStores(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to list OpenAI vector stores", "", err)
+		return
+	}
+
+	data.VectorStores = []vectorStoresItemModel{}
+	for _, store := range stores {
+		item := vectorStoresItemModel{
+			ID:         types.StringValue(store.ID),
+			Name:       types.StringValue(store.Name),
+			Status:     types.StringValue(store.Status),
+			UsageBytes: types.Int64Value(store.UsageBytes),
+			CreatedAt:  types.Int64Value(store.CreatedAt),
+		}
+
+		item.FileCounts, diags = store.fileCounts()
+		resp.Diagnostics.Append(diags...)
+
+		if store.Metadata == nil {
+			store.Metadata = map[string]string{}
+		}
+		item.Metadata, diags = types.MapValueFrom(ctx, types.StringType, store.Metadata)
+		resp.Diagnostics.Append(diags...)
+
+		data.VectorStores = append(data.VectorStores, item)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listVectorStores lists the vector stores of the project, from the most
+// recent.
+func (c *openaiClient) listVectorStores(ctx context.Context) ([]vectorStoreObject, error) {
+	var stores []vectorStoreObject
+
+	query := url.Values{"order": {"desc"}, "limit": {"100"}}
+	for {
+		var page struct {
+			Data    []vectorStoreObject `json:"data"`
+			HasMore bool                `json:"has_more"`
+			LastID  string              `json:"last_id"`
+		}
+		if err := c.doJSON(ctx, http.MethodGet, "/vector_stores?"+query.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+
+		stores = append(stores, page.Data...)
+		if !page.HasMore || page.LastID == "" {
+			return stores, nil
+		}
+		query.Set("after", page.LastID)
+	}
+}
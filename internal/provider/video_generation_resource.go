@@ -0,0 +1,417 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &videoGenerationResource{}
+	_ resource.ResourceWithConfigure      = &videoGenerationResource{}
+	_ resource.ResourceWithValidateConfig = &videoGenerationResource{}
+)
+
+// videoGenerationPollInterval is the interval between two checks of the
+// status of a video generation job. Videos take minutes to render.
+const videoGenerationPollInterval = 10 * time.Second
+
+// videoGenerationTimeout is the default create timeout of a video, long
+// enough for the queue and the rendering of the longest videos.
+const videoGenerationTimeout = time.Hour
+
+// videoSeconds lists the supported durations of a video, in seconds.
+var videoSeconds = []int64{4, 8, 12}
+
+// videoSizes lists the supported resolutions of the known video models.
+// Models missing from this list are not validated.
+var videoSizes = map[string][]string{
+	"sora-2":     {"720x1280", "1280x720"},
+	"sora-2-pro": {"720x1280", "1280x720", "1024x1792", "1792x1024"},
+}
+
+// NewVideoGenerationResource is a helper function to simplify the provider implementation.
+func NewVideoGenerationResource() resource.Resource {
+	return &videoGenerationResource{}
+}
+
+// videoGenerationResource is the resource implementation.
+type videoGenerationResource struct {
+	client *openaiClient
+}
+
+// videoGenerationResourceModel maps the resource schema data.
+type videoGenerationResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Prompt       types.String   `tfsdk:"prompt"`
+	Model        types.String   `tfsdk:"model"`
+	Seconds      types.Int64    `tfsdk:"seconds"`
+	Size         types.String   `tfsdk:"size"`
+	OutputPath   types.String   `tfsdk:"output_path"`
+	Keepers      types.Map      `tfsdk:"keepers"`
+	PromptSHA256 types.String   `tfsdk:"prompt_sha256"`
+	OutputSHA256 types.String   `tfsdk:"output_sha256"`
+	Project      types.String   `tfsdk:"project"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// videoGenerationRequest is the body of a request creating a video. The
+// duration is sent as a string, as required by the API.
+type videoGenerationRequest struct {
+	Prompt  string `json:"prompt"`
+	Model   string `json:"model,omitempty"`
+	Seconds string `json:"seconds,omitempty"`
+	Size    string `json:"size,omitempty"`
+}
+
+// videoObject is a video generation job returned by the Videos API.
+type videoObject struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Metadata returns the resource type name.
+func (r *videoGenerationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_video_generation"
+}
+
+// Schema defines the schema for the resource.
+func (r *videoGenerationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a video from a prompt with a Sora model, waits for it to be rendered and writes it to `output_path`. Videos are slow and expensive to generate: the video is only generated again when one of the arguments, or one of the `keepers`, changes, or when the file is removed. The video is deleted from OpenAI on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the video generation job.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prompt": schema.StringAttribute{
+				Description: "Text description of the desired video.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Model used to generate the video, either `sora-2` or `sora-2-pro`. Defaults to `sora-2`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("sora-2", "sora-2-pro"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"seconds": schema.Int64Attribute{
+				MarkdownDescription: "Duration of the video, either `4`, `8` or `12` seconds. Defaults to `4`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(videoSeconds...),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.StringAttribute{
+				MarkdownDescription: "Resolution of the video. `sora-2` supports `720x1280` and `1280x720`, `sora-2-pro` also supports `1024x1792` and `1792x1024`. Defaults to `720x1280`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output_path": schema.StringAttribute{
+				Description: "Path to the MP4 file the video is written to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary key-value pairs generating the video again when changed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"prompt_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the prompt the video was generated from.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the generated video.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "ID of the OpenAI project the video is billed to, overriding the `project_id` of the provider.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *videoGenerationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*openaiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *openaiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig validates the resolution of the video against the model.
+func (r *videoGenerationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config videoGenerationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Model.IsUnknown() || config.Size.IsNull() || config.Size.IsUnknown() {
+		return
+	}
+
+	modelName := "sora-2"
+	if !config.Model.IsNull() {
+		modelName = config.Model.ValueString()
+	}
+
+	sizes, ok := videoSizes[modelName]
+	if !ok {
+		return
+	}
+	for _, size := range sizes {
+		if size == config.Size.ValueString() {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("size"),
+		"Unsupported size",
+		fmt.Sprintf("size %q is not supported by %s, supported values are: %s.", config.Size.ValueString(), modelName, strings.Join(sizes, ", ")),
+	)
+}
+
+// Create a new resource.
+func (r *videoGenerationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan videoGenerationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, videoGenerationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	ctx = withProject(ctx, plan.Project)
+	defer r.client.warnNearQuota(&resp.Diagnostics)
+
+	videoRequest := videoGenerationRequest{
+		Prompt: plan.Prompt.ValueString(),
+		Model:  plan.Model.ValueString(),
+		Size:   plan.Size.ValueString(),
+	}
+	if !plan.Seconds.IsNull() {
+		videoRequest.Seconds = strconv.FormatInt(plan.Seconds.ValueInt64(), 10)
+	}
+
+	var video videoObject
+	err := r.client.doJSON(ctx, http.MethodPost, "/videos", videoRequest, &video)
+	if err != nil {
+		addModelAPIError(&resp.Diagnostics, path.Root("model"), "Error generating video", "Could not create video generation job, unexpected error: ", err)
+		return
+	}
+
+	// Save the ID right away, so the video is deleted on destroy even when
+	// the generation fails.
+	plan.ID = types.StringValue(video.ID)
+	plan.PromptSHA256 = types.StringValue(sha256Hex([]byte(plan.Prompt.ValueString())))
+	plan.OutputSHA256 = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	video, err = r.client.waitVideo(ctx, video)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error generating video", "Video generation job "+video.ID+" did not complete: ", err)
+		return
+	}
+
+	var content []byte
+	err = r.client.doJSON(ctx, http.MethodGet, "/videos/"+video.ID+"/content", nil, &content)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error downloading video", "Could not download video "+video.ID+", unexpected error: ", err)
+		return
+	}
+
+	if err := writeLocalFile(plan.OutputPath.ValueString(), content); err != nil {
+		resp.Diagnostics.AddError(
+			"Error saving video",
+			"Could not write video file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.OutputSHA256 = types.StringValue(sha256Hex(content))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *videoGenerationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state videoGenerationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate the video again when the file has been removed.
+	if !localFileExists(state.OutputPath.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *videoGenerationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires a replacement, there is nothing to send to OpenAI.
+	var plan videoGenerationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *videoGenerationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state videoGenerationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	ctx = withProject(ctx, state.Project)
+
+	// Delete existing video
+	err := r.client.doJSON(ctx, http.MethodDelete, "/videos/"+state.ID.ValueString(), nil, nil)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Deleting OpenAI video", "Could not delete video, unexpected error: ", err)
+		return
+	}
+
+	if err := removeLocalFile(state.OutputPath.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting video file",
+			"Could not delete video file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// waitVideo polls the video generation job until it reaches a terminal status.
+func (c *openaiClient) waitVideo(ctx context.Context, video videoObject) (videoObject, error) {
+	ctx = withFreshReads(ctx)
+
+	for {
+		switch video.Status {
+		case "completed":
+			return video, nil
+		case "failed":
+			if video.Error != nil {
+				return video, fmt.Errorf("video generation failed with %s: %s", video.Error.Code, video.Error.Message)
+			}
+			return video, fmt.Errorf("video generation failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return video, ctx.Err()
+		case <-time.After(videoGenerationPollInterval):
+		}
+
+		// The first polls can hit a replica not aware of the new video yet.
+		err := retryNotFound(ctx, func(ctx context.Context) error {
+			return c.doJSON(ctx, http.MethodGet, "/videos/"+video.ID, nil, &video)
+		})
+		if err != nil {
+			return video, err
+		}
+	}
+}